@@ -1,7 +1,9 @@
 package main
 
 import (
+	"log"
 	"net/http"
+	"os"
 
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
@@ -9,6 +11,7 @@ import (
 
 	_ "github.com/variant64/server/docs"
 	"github.com/variant64/server/pkg/api"
+	"github.com/variant64/server/pkg/models/board/variants"
 )
 
 //	@title		Variant64 Server
@@ -17,6 +20,13 @@ import (
 // @host		localhost:8000
 // @BasePath	/api
 func main() {
+	// Variants declared in JSON or YAML files are loaded from VARIANTS_DIR.
+	if dir := os.Getenv("VARIANTS_DIR"); dir != "" {
+		if err := variants.LoadVariantDefinitions(dir); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	r := mux.NewRouter()
 
 	r.PathPrefix("/swagger").Handler(httpSwagger.WrapHandler)
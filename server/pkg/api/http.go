@@ -3,6 +3,7 @@ package api
 import (
 	"net/http"
 
+	"github.com/variant64/server/pkg/models/board/variants"
 	"github.com/variant64/server/pkg/models/game"
 	"github.com/variant64/server/pkg/models/player"
 	"github.com/variant64/server/pkg/models/room"
@@ -136,6 +137,20 @@ func handlePostGamePlayerApproveDraw(w http.ResponseWriter, req *http.Request) {
 	handleActionRoute[*game.Game](w, req, &game.RequestApproveDraw{})
 }
 
+// @Summary	Player claims a draw, e.g. by the fifty-move rule or threefold repetition.
+// @Accept	json
+// @Produce	json
+// @Router	/api/game/{game_id}/draw/claim [post]
+// @Param	game_id	path		string					true	"game id"
+// @Param	request	body		game.RequestClaimDraw	true	"request body"
+// @Success	200		{object}	game.Game
+// @Failure	400		{object}	errorResponse
+// @Failure	404		{object}	errorResponse
+// @Failure	500		{object}	errorResponse
+func handlePostGamePlayerClaimDraw(w http.ResponseWriter, req *http.Request) {
+	handleActionRoute[*game.Game](w, req, &game.RequestClaimDraw{})
+}
+
 // @Summary	Player rejects a game to draw.
 // @Accept	json
 // @Produce	json
@@ -150,6 +165,42 @@ func handlePostGamePlayerRejectDraw(w http.ResponseWriter, req *http.Request) {
 	handleActionRoute[*game.Game](w, req, &game.RequestRejectDraw{})
 }
 
+// @Summary	Get the FEN of a game's board.
+// @Produce	json
+// @Router	/api/game/{game_id}/fen [get]
+// @Param	game_id	path		string	true	"game id"
+// @Success	200		{object}	game.GameFEN
+// @Failure	400		{object}	errorResponse
+// @Failure	404		{object}	errorResponse
+// @Failure	500		{object}	errorResponse
+func handleGetGameFEN(w http.ResponseWriter, req *http.Request) {
+	handleActionRoute[*game.GameFEN](w, req, &game.RequestGetGameFEN{})
+}
+
+// @Summary	Export a game as PGN.
+// @Produce	json
+// @Router	/api/game/{game_id}/pgn [get]
+// @Param	game_id	path		string	true	"game id"
+// @Success	200		{object}	game.GamePGN
+// @Failure	400		{object}	errorResponse
+// @Failure	404		{object}	errorResponse
+// @Failure	500		{object}	errorResponse
+func handleGetGamePGN(w http.ResponseWriter, req *http.Request) {
+	handleActionRoute[*game.GamePGN](w, req, &game.RequestGetGamePGN{})
+}
+
+// @Summary	Get the moves played in a game.
+// @Produce	json
+// @Router	/api/game/{game_id}/moves [get]
+// @Param	game_id	path		string	true	"game id"
+// @Success	200		{object}	game.GameMoves
+// @Failure	400		{object}	errorResponse
+// @Failure	404		{object}	errorResponse
+// @Failure	500		{object}	errorResponse
+func handleGetGameMoves(w http.ResponseWriter, req *http.Request) {
+	handleActionRoute[*game.GameMoves](w, req, &game.RequestGetGameMoves{})
+}
+
 // @Summary Make a move.
 // @Accept json
 // @Produce json
@@ -163,3 +214,12 @@ func handlePostGamePlayerRejectDraw(w http.ResponseWriter, req *http.Request) {
 func handlePostGamePlayerMakeMove(w http.ResponseWriter, req *http.Request) {
 	handleActionRoute[*game.Game](w, req, &game.RequestMakeMove{})
 }
+
+// @Summary	Get all supported variants.
+// @Produce	json
+// @Router	/api/variants [get]
+// @Success	200	{array}		variants.Variant
+// @Failure	500	{object}	errorResponse
+func handleGetVariants(w http.ResponseWriter, req *http.Request) {
+	handleActionRoute[[]*variants.Variant](w, req, &variants.RequestGetVariants{})
+}
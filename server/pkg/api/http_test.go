@@ -733,6 +733,53 @@ func TestGameDrawReject(t *testing.T) {
 	}
 }
 
+func TestGameDrawClaim(t *testing.T) {
+	testEntities1 := Setup(
+		WithPlayers(2),
+		WithPlayersInRoom(2),
+		WithRoom(),
+		WithGame(),
+	)
+
+	testcases := []struct {
+		description              string
+		id                       string
+		body                     string
+		expectedResponseContains []string
+		expectedStatusCode       int
+	}{
+		{
+			"Draw not claimable from the starting position.",
+			testEntities1.game1.GetID().String(),
+			fmt.Sprintf("{\"player_id\":\"%s\"}", testEntities1.player1.GetID()),
+			[]string{
+				"draw cannot be claimed",
+			},
+			400,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			router := &mux.Router{}
+			AttachRoutes(router)
+
+			request, _ := http.NewRequest(
+				"POST",
+				fmt.Sprintf("/api/game/%s/draw/claim", tc.id),
+				strings.NewReader(tc.body),
+			)
+			writer := executeRequest(router, request)
+
+			assert.Equal(t, tc.expectedStatusCode, writer.statusCode)
+			responseString := string(writer.response)
+			for _, e := range tc.expectedResponseContains {
+				assert.Contains(t, responseString, e)
+			}
+		})
+	}
+}
+
 func TestGamePlayerMakeMove(t *testing.T) {
 	testEntities1 := Setup(
 		WithPlayers(2),
@@ -783,6 +830,159 @@ func TestGamePlayerMakeMove(t *testing.T) {
 	}
 }
 
+func TestGameGetFEN(t *testing.T) {
+	testEntities1 := Setup(
+		WithPlayers(2),
+		WithPlayersInRoom(2),
+		WithRoom(),
+		WithGame(),
+	)
+
+	testcases := []struct {
+		description              string
+		id                       string
+		expectedResponseContains []string
+		expectedStatusCode       int
+	}{
+		{
+			"Valid gameID.",
+			testEntities1.game1.GetID().String(),
+			[]string{
+				"\"fen\":\"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1\"",
+			},
+			200,
+		},
+		{
+			"Non-existent gameID.",
+			uuid.New().String(),
+			[]string{},
+			404,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			router := &mux.Router{}
+			AttachRoutes(router)
+
+			request, _ := http.NewRequest(
+				"GET",
+				fmt.Sprintf("/api/game/%s/fen", tc.id),
+				nil,
+			)
+			writer := executeRequest(router, request)
+
+			assert.Equal(t, tc.expectedStatusCode, writer.statusCode)
+			responseString := string(writer.response)
+			for _, e := range tc.expectedResponseContains {
+				assert.Contains(t, responseString, e)
+			}
+		})
+	}
+}
+
+func TestGameGetPGN(t *testing.T) {
+	testEntities1 := Setup(
+		WithPlayers(2),
+		WithPlayersInRoom(2),
+		WithRoom(),
+		WithGame(),
+	)
+
+	testcases := []struct {
+		description              string
+		id                       string
+		expectedResponseContains []string
+		expectedStatusCode       int
+	}{
+		{
+			"Valid gameID.",
+			testEntities1.game1.GetID().String(),
+			[]string{
+				"[Variant \\\"classic\\\"]",
+			},
+			200,
+		},
+		{
+			"Non-existent gameID.",
+			uuid.New().String(),
+			[]string{},
+			404,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			router := &mux.Router{}
+			AttachRoutes(router)
+
+			request, _ := http.NewRequest(
+				"GET",
+				fmt.Sprintf("/api/game/%s/pgn", tc.id),
+				nil,
+			)
+			writer := executeRequest(router, request)
+
+			assert.Equal(t, tc.expectedStatusCode, writer.statusCode)
+			responseString := string(writer.response)
+			for _, e := range tc.expectedResponseContains {
+				assert.Contains(t, responseString, e)
+			}
+		})
+	}
+}
+
+func TestGameGetMoves(t *testing.T) {
+	testEntities1 := Setup(
+		WithPlayers(2),
+		WithPlayersInRoom(2),
+		WithRoom(),
+		WithGame(),
+	)
+
+	testcases := []struct {
+		description              string
+		id                       string
+		expectedResponseContains []string
+		expectedStatusCode       int
+	}{
+		{
+			"Valid gameID.",
+			testEntities1.game1.GetID().String(),
+			[]string{
+				"\"moves\":[]",
+			},
+			200,
+		},
+		{
+			"Non-existent gameID.",
+			uuid.New().String(),
+			[]string{},
+			404,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			router := &mux.Router{}
+			AttachRoutes(router)
+
+			request, _ := http.NewRequest(
+				"GET",
+				fmt.Sprintf("/api/game/%s/moves", tc.id),
+				nil,
+			)
+			writer := executeRequest(router, request)
+
+			assert.Equal(t, tc.expectedStatusCode, writer.statusCode)
+			responseString := string(writer.response)
+			for _, e := range tc.expectedResponseContains {
+				assert.Contains(t, responseString, e)
+			}
+		})
+	}
+}
+
 type setupOption = func(s *setupBuilder)
 
 type setupBuilder struct {
@@ -950,3 +1150,41 @@ func executeRequest(router *mux.Router, request *http.Request) *mockWriter {
 	router.ServeHTTP(mockWriter, request)
 	return mockWriter
 }
+
+func TestVariantsGet(t *testing.T) {
+	testcases := []struct {
+		description              string
+		expectedResponseContains []string
+		expectedStatusCode       int
+	}{
+		{
+			"All variants.",
+			[]string{
+				"\"name\":\"classic\"",
+				"\"name\":\"chess960\"",
+				"\"name\":\"capablanca\"",
+				"\"name\":\"gardner\"",
+				"\"name\":\"los_alamos\"",
+				"\"bounds\":{\"rank\":8,\"file\":10}",
+				"\"player_count\":2",
+			},
+			200,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			router := &mux.Router{}
+			AttachRoutes(router)
+
+			request, _ := http.NewRequest("GET", "/api/variants", nil)
+			writer := executeRequest(router, request)
+
+			assert.Equal(t, tc.expectedStatusCode, writer.statusCode)
+			responseString := string(writer.response)
+			for _, e := range tc.expectedResponseContains {
+				assert.Contains(t, responseString, e)
+			}
+		})
+	}
+}
@@ -26,7 +26,12 @@ var httpRoutes = []route{
 	{"/api/game/{game_id}/concede", "Player concedes a Game.", handlePostGamePlayerConcede, []string{"POST"}},
 	{"/api/game/{game_id}/draw/approve", "Player approves a drawn Game.", handlePostGamePlayerApproveDraw, []string{"POST"}},
 	{"/api/game/{game_id}/draw/reject", "Player rejects a drawn Game.", handlePostGamePlayerRejectDraw, []string{"POST"}},
+	{"/api/game/{game_id}/draw/claim", "Player claims a drawn Game.", handlePostGamePlayerClaimDraw, []string{"POST"}},
 	{"/api/game/{game_id}/move", "Player makes a move in the game.", handlePostGamePlayerMakeMove, []string{"POST"}},
+	{"/api/game/{game_id}/fen", "Get the FEN of a Game's board.", handleGetGameFEN, []string{"GET"}},
+	{"/api/game/{game_id}/moves", "Get the moves played in a Game.", handleGetGameMoves, []string{"GET"}},
+	{"/api/game/{game_id}/pgn", "Export a Game as PGN.", handleGetGamePGN, []string{"GET"}},
+	{"/api/variants", "Get all supported Variants.", handleGetVariants, []string{"GET"}},
 }
 
 var websocketRoutes = []route{
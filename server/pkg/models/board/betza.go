@@ -0,0 +1,155 @@
+package board
+
+var (
+	orthogonalDirections = []Direction{North, East, South, West}
+	diagonalDirections   = []Direction{NorthEast, SouthEast, SouthWest, NorthWest}
+	allDirections        = []Direction{North, NorthEast, East, SouthEast, South, SouthWest, West, NorthWest}
+)
+
+// betzaLeaps maps the Betza leaper atoms to their leaps.
+var betzaLeaps = map[rune][]leap{
+	'W': {{rank: 1, file: 0}},
+	'F': {{rank: 1, file: 1}},
+	'K': {{rank: 1, file: 0}, {rank: 1, file: 1}},
+	'D': {{rank: 2, file: 0}},
+	'N': {{rank: 2, file: 1}},
+	'A': {{rank: 2, file: 2}},
+	'H': {{rank: 3, file: 0}},
+	'C': {{rank: 3, file: 1}},
+	'Z': {{rank: 3, file: 2}},
+	'G': {{rank: 3, file: 3}},
+}
+
+// betzaRays maps the Betza slider atoms to the directions of their rays.
+var betzaRays = map[rune][]Direction{
+	'R': orthogonalDirections,
+	'B': diagonalDirections,
+	'Q': allDirections,
+}
+
+// betzaHops maps the Betza atoms that can be hopped along to their directions.
+var betzaHops = map[rune][]Direction{
+	'W': orthogonalDirections,
+	'R': orthogonalDirections,
+	'F': diagonalDirections,
+	'B': diagonalDirections,
+	'K': allDirections,
+	'Q': allDirections,
+}
+
+// NewBetzaPiece creates a Piece of the PieceType that moves as described by the Betza notation,
+// e.g. "BN" for a piece moving as a bishop or a knight.
+// The notation is a list of the leaper atoms W, F, K, D, N, A, H, C, Z and G and slider atoms R, B and Q.
+// A doubled leaper atom rides, e.g. "NN" for a nightrider,
+// and a slider atom prefixed with g hops over a piece, e.g. "gQ" for a grasshopper.
+func NewBetzaPiece(color Color, pieceType PieceType, notation string, bounds Bounds) (*Piece, error) {
+	if notation == "" {
+		return nil, errInvalidBetza(notation, "no atoms")
+	}
+
+	moveGenerators := []moveGenerator{}
+	atoms := []rune(notation)
+	for i := 0; i < len(atoms); i++ {
+		hop := atoms[i] == 'g'
+		if hop {
+			i++
+			if i == len(atoms) {
+				return nil, errInvalidBetza(notation, "missing atom to hop along")
+			}
+		}
+		atom := atoms[i]
+		ride := i+1 < len(atoms) && atoms[i+1] == atom
+		if ride {
+			i++
+		}
+
+		atomMoveGenerators, err := betzaMoveGenerators(notation, atom, hop, ride, bounds)
+		if err != nil {
+			return nil, err
+		}
+		moveGenerators = append(moveGenerators, atomMoveGenerators...)
+	}
+
+	return NewPiece(color, pieceType, moveGenerators...), nil
+}
+
+// betzaMoveGenerators returns the moveGenerators of a single atom of the Betza notation.
+func betzaMoveGenerators(notation string, atom rune, hop, ride bool, bounds Bounds) ([]moveGenerator, error) {
+	moveGenerators := []moveGenerator{}
+	switch {
+	case hop:
+		directions, ok := betzaHops[atom]
+		if !ok || ride {
+			return nil, errInvalidBetza(notation, "cannot hop along "+string(atom))
+		}
+		for _, direction := range directions {
+			moveGenerators = append(moveGenerators, NewHopperMoveGenerator(direction, bounds))
+		}
+	case betzaRays[atom] != nil:
+		if ride {
+			return nil, errInvalidBetza(notation, "cannot ride "+string(atom))
+		}
+		for _, direction := range betzaRays[atom] {
+			moveGenerators = append(moveGenerators, &RayMoveGenerator{direction: direction, bounds: bounds})
+		}
+	case betzaLeaps[atom] != nil:
+		for _, l := range betzaLeaps[atom] {
+			if !ride {
+				moveGenerators = append(moveGenerators, NewLeaperMoveGenerator(l.rank, l.file))
+				continue
+			}
+			// Rides are traced by their shortest leap.
+			if gcd(l.rank, l.file) != 1 {
+				return nil, errInvalidBetza(notation, "cannot ride "+string(atom))
+			}
+			moveGenerators = append(moveGenerators, NewRiderMoveGenerator(l.rank, l.file, bounds))
+		}
+	default:
+		return nil, errInvalidBetza(notation, "unsupported atom "+string(atom))
+	}
+	return moveGenerators, nil
+}
+
+// PieceMovements maps PieceTypes to their movement in Betza notation,
+// PieceTypes without a movement move as they do in classic chess.
+type PieceMovements map[PieceType]string
+
+// NewPiece creates a Piece of the PieceType following its movement.
+func (p PieceMovements) NewPiece(color Color, pieceType PieceType, bounds Bounds) (*Piece, error) {
+	notation, ok := p[pieceType]
+	if !ok {
+		return NewPieceOfType(color, pieceType, bounds)
+	}
+	return NewBetzaPiece(color, pieceType, notation, bounds)
+}
+
+// ReplacePieces replaces the pieces of the GameboardState with pieces following their movements.
+func (p PieceMovements) ReplacePieces(state GameboardState, bounds Bounds) error {
+	for rank, files := range state {
+		for file, piece := range files {
+			if piece == nil {
+				continue
+			}
+			replacement, err := p.NewPiece(piece.Color, piece.PieceType, bounds)
+			if err != nil {
+				return err
+			}
+			state[rank][file] = replacement
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if any of the movements is invalid,
+// pawns can't be given a movement as their moves depend on the rules.
+func (p PieceMovements) Validate() error {
+	for pieceType, notation := range p {
+		if pieceType == PAWN || pieceType == NONE {
+			return errInvalidBetza(notation, "cannot be used for "+pieceType.String())
+		}
+		if _, err := NewBetzaPiece(WHITE, pieceType, notation, Bounds{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
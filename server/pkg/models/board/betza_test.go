@@ -0,0 +1,243 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBetzaPiece(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	source := Position{Rank: 3, File: 3}
+	testcases := []struct {
+		name          string
+		notation      string
+		expectedPiece *Piece
+		expectErr     bool
+	}{
+		{
+			name:          "Rook.",
+			notation:      "R",
+			expectedPiece: NewRook(WHITE, bounds),
+		},
+		{
+			name:          "Queen from a single atom.",
+			notation:      "Q",
+			expectedPiece: NewQueen(WHITE, bounds),
+		},
+		{
+			name:          "Queen from compound atoms.",
+			notation:      "RB",
+			expectedPiece: NewQueen(WHITE, bounds),
+		},
+		{
+			name:          "Archbishop.",
+			notation:      "BN",
+			expectedPiece: NewArchbishop(WHITE, bounds),
+		},
+		{
+			name:          "Knight.",
+			notation:      "N",
+			expectedPiece: NewKnight(WHITE),
+		},
+		{
+			name:          "King from steps.",
+			notation:      "WF",
+			expectedPiece: NewPiece(WHITE, KING, NewLeaperMoveGenerator(1, 0), NewLeaperMoveGenerator(1, 1)),
+		},
+		{
+			name:          "King.",
+			notation:      "K",
+			expectedPiece: NewPiece(WHITE, KING, NewLeaperMoveGenerator(1, 0), NewLeaperMoveGenerator(1, 1)),
+		},
+		{
+			name:          "Camel.",
+			notation:      "C",
+			expectedPiece: NewPiece(WHITE, KNIGHT, NewLeaperMoveGenerator(3, 1)),
+		},
+		{
+			name:          "Nightrider.",
+			notation:      "NN",
+			expectedPiece: NewPiece(WHITE, KNIGHT, NewRiderMoveGenerator(2, 1, bounds)),
+		},
+		{
+			name:     "Grasshopper.",
+			notation: "gQ",
+			expectedPiece: NewPiece(
+				WHITE,
+				KNIGHT,
+				NewHopperMoveGenerator(North, bounds),
+				NewHopperMoveGenerator(NorthEast, bounds),
+				NewHopperMoveGenerator(East, bounds),
+				NewHopperMoveGenerator(SouthEast, bounds),
+				NewHopperMoveGenerator(South, bounds),
+				NewHopperMoveGenerator(SouthWest, bounds),
+				NewHopperMoveGenerator(West, bounds),
+				NewHopperMoveGenerator(NorthWest, bounds),
+			),
+		},
+		{
+			name:      "Ride of a multiple of a shorter leap.",
+			notation:  "DD",
+			expectErr: true,
+		},
+		{
+			name:      "Ride of a slider.",
+			notation:  "RR",
+			expectErr: true,
+		},
+		{
+			name:      "Hop along a leap.",
+			notation:  "gN",
+			expectErr: true,
+		},
+		{
+			name:      "Hop without an atom.",
+			notation:  "Rg",
+			expectErr: true,
+		},
+		{
+			name:      "Unsupported atom.",
+			notation:  "BX",
+			expectErr: true,
+		},
+		{
+			name:      "Empty notation.",
+			notation:  "",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			piece, err := NewBetzaPiece(WHITE, QUEEN, tc.notation, bounds)
+			if tc.expectErr {
+				assert.Nil(t, piece)
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, QUEEN, piece.GetType())
+
+			moves := piece.GenerateMoves(source)
+			expectedMoves := tc.expectedPiece.GenerateMoves(source)
+			assert.Equal(t, len(expectedMoves), len(moves))
+			for moveType, destinations := range expectedMoves {
+				assert.ElementsMatch(t, destinations, moves[moveType])
+			}
+		})
+	}
+}
+
+func TestPieceMovementsNewPiece(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	source := Position{Rank: 3, File: 3}
+	pieceMovements := PieceMovements{KNIGHT: "NW"}
+
+	knight, err := pieceMovements.NewPiece(BLACK, KNIGHT, bounds)
+	assert.Nil(t, err)
+	assert.Equal(t, KNIGHT, knight.GetType())
+	assert.Len(t, knight.GenerateMoves(source)[JUMP], 12)
+
+	bishop, err := pieceMovements.NewPiece(BLACK, BISHOP, bounds)
+	assert.Nil(t, err)
+	assert.Equal(t, NewBishop(BLACK, bounds).GenerateMoves(source), bishop.GenerateMoves(source))
+}
+
+func TestPieceMovementsReplacePieces(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	source := Position{Rank: 3, File: 3}
+	state := NewGameboardState(
+		bounds,
+		GameboardState{
+			3: {3: NewKing(WHITE)},
+			4: {4: NewPawn(BLACK)},
+		},
+	)
+
+	assert.Nil(t, PieceMovements{KING: "K"}.ReplacePieces(state, bounds))
+	assert.Equal(t, Color(WHITE), state[3][3].GetColor())
+	assert.Len(t, state[3][3].GenerateMoves(source)[JUMP_CAPTURE], 8)
+	assert.Equal(t, NewPawn(BLACK), state[4][4])
+}
+
+func TestPieceMovementsValidate(t *testing.T) {
+	testcases := []struct {
+		name           string
+		pieceMovements PieceMovements
+		expectErr      bool
+	}{
+		{
+			name:           "Valid movements.",
+			pieceMovements: PieceMovements{KNIGHT: "NW", ARCHBISHOP: "BN"},
+		},
+		{
+			name:           "No movements.",
+			pieceMovements: nil,
+		},
+		{
+			name:           "Invalid notation.",
+			pieceMovements: PieceMovements{KNIGHT: "Nx"},
+			expectErr:      true,
+		},
+		{
+			name:           "Pawn movement.",
+			pieceMovements: PieceMovements{PAWN: "W"},
+			expectErr:      true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pieceMovements.Validate()
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestFairyPieceMoves(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	camel, _ := NewBetzaPiece(WHITE, KNIGHT, "C", bounds)
+	nightrider, _ := NewBetzaPiece(WHITE, BISHOP, "NN", bounds)
+	grasshopper, _ := NewBetzaPiece(WHITE, ROOK, "gQ", bounds)
+	fairyBoard := Build(
+		WithBounds(bounds),
+		WithGameboardState(
+			GameboardState{
+				0: {0: nightrider, 4: NewKing(WHITE), 7: grasshopper},
+				3: {3: camel},
+				4: {2: NewPawn(BLACK)},
+				6: {3: NewPawn(BLACK), 4: NewKing(BLACK), 7: NewPawn(BLACK)},
+				7: {7: NewPawn(BLACK)},
+			},
+		),
+	)
+
+	camelMoves := fairyBoard.GameboardState[3][3].AvailableMoves
+	assert.Contains(t, camelMoves[JUMP], Position{Rank: 6, File: 2})
+	assert.NotContains(t, camelMoves[JUMP], Position{Rank: 0, File: 4})
+	assert.NotContains(t, camelMoves[JUMP_CAPTURE], Position{Rank: 0, File: 4})
+	assert.NotContains(t, camelMoves[JUMP], Position{Rank: 5, File: 4})
+
+	nightriderMoves := fairyBoard.GameboardState[0][0].AvailableMoves
+	assert.Contains(t, nightriderMoves[RIDE], Position{Rank: 2, File: 1})
+	assert.NotContains(t, nightriderMoves[RIDE], Position{Rank: 4, File: 2})
+	assert.Contains(t, nightriderMoves[RIDE_CAPTURE], Position{Rank: 4, File: 2})
+	assert.NotContains(t, nightriderMoves[RIDE_CAPTURE], Position{Rank: 6, File: 3})
+
+	grasshopperMoves := fairyBoard.GameboardState[0][7].AvailableMoves
+	assert.Equal(t, []Position{{Rank: 0, File: 3}}, grasshopperMoves[HOP])
+	assert.Contains(t, grasshopperMoves[HOP_CAPTURE], Position{Rank: 7, File: 7})
+
+	assert.Nil(t, fairyBoard.HandleMove(Move{
+		Source:      Position{Rank: 0, File: 0},
+		Destination: Position{Rank: 4, File: 2},
+		MoveType:    RIDE_CAPTURE,
+	}))
+	assert.Equal(t, nightrider, fairyBoard.GameboardState[4][2])
+	assert.Nil(t, fairyBoard.GameboardState[0][0])
+}
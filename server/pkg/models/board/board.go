@@ -9,24 +9,42 @@ import (
 type GameboardType string
 
 const (
-	GameboardTypeDefault GameboardType = ""
-	GameboardTypeClassic GameboardType = "classic"
+	GameboardTypeDefault       GameboardType = ""
+	GameboardTypeClassic       GameboardType = "classic"
+	GameboardTypeChess960      GameboardType = "chess960"
+	GameboardTypeCapablanca    GameboardType = "capablanca"
+	GameboardTypeGardner       GameboardType = "gardner"
+	GameboardTypeLosAlamos     GameboardType = "los_alamos"
+	GameboardTypeKingOfTheHill GameboardType = "king_of_the_hill"
+	GameboardTypeThreeCheck    GameboardType = "three_check"
+	GameboardTypeAtomic        GameboardType = "atomic"
+	GameboardTypeAntichess     GameboardType = "antichess"
+	GameboardTypeCrazyhouse    GameboardType = "crazyhouse"
+	GameboardTypeFourPlayer    GameboardType = "four_player"
+	GameboardTypeCylinder      GameboardType = "cylinder"
+	GameboardTypeTorus         GameboardType = "torus"
 )
 
 type GameboardState = map[int]map[int]*Piece
 
+// NewGameboardState returns a GameboardState of the Bounds with the provided pieces placed,
+// squares masked off the Bounds are left out and pieces placed on them are dropped.
 func NewGameboardState(bounds Bounds, state GameboardState) GameboardState {
 	gameboardState := GameboardState{}
 	for rank := 0; rank < bounds.RankCount; rank += 1 {
 		gameboardState[rank] = map[int]*Piece{}
 		for file := 0; file < bounds.FileCount; file += 1 {
-			gameboardState[rank][file] = nil
+			if !bounds.IsVoid(Position{Rank: rank, File: file}) {
+				gameboardState[rank][file] = nil
+			}
 		}
 	}
 
 	for rank := range state {
 		for file := range state[rank] {
-			gameboardState[rank][file] = state[rank][file]
+			if _, ok := gameboardState[rank][file]; ok {
+				gameboardState[rank][file] = state[rank][file]
+			}
 		}
 	}
 
@@ -57,6 +75,8 @@ const (
 	KNIGHT
 	KING
 	QUEEN
+	ARCHBISHOP
+	CHANCELLOR
 )
 
 func (p PieceType) String() string {
@@ -75,6 +95,13 @@ func (p PieceType) String() string {
 		return "king"
 	case QUEEN:
 		return "queen"
+	case ARCHBISHOP:
+		return "archbishop"
+	case CHANCELLOR:
+		return "chancellor"
+	}
+	if piece, ok := getFairyPiece(p); ok {
+		return piece.name
 	}
 	return "invalid"
 }
@@ -83,12 +110,59 @@ func (p PieceType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(p.String())
 }
 
+func (p PieceType) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *PieceType) UnmarshalJSON(data []byte) error {
+	pieceType, err := ParsePieceType(strings.Trim(string(data), "\""))
+	if err != nil {
+		return errors.New("invalid string value for PieceType")
+	}
+	*p = pieceType
+	return nil
+}
+
+// builtinPieceTypes are the PieceTypes that don't need to be registered.
+var builtinPieceTypes = []PieceType{
+	NONE,
+	PAWN,
+	ROOK,
+	BISHOP,
+	KNIGHT,
+	KING,
+	QUEEN,
+	ARCHBISHOP,
+	CHANCELLOR,
+}
+
+// ParsePieceType returns the PieceType with the provided name, e.g. "knight",
+// registered fairy PieceTypes included.
+func ParsePieceType(name string) (PieceType, error) {
+	for _, pieceType := range builtinPieceTypes {
+		if pieceType.String() == name {
+			return pieceType, nil
+		}
+	}
+	pieceType, ok := findFairyPieceType(func(piece fairyPiece) bool {
+		return piece.name == name
+	})
+	if ok {
+		return pieceType, nil
+	}
+	return NONE, errInvalidPieceTypeName(name)
+}
+
 type Color int
 
 const (
 	NO_COLOR = iota
 	BLACK
 	WHITE
+	RED
+	BLUE
+	YELLOW
+	GREEN
 )
 
 func (c Color) String() string {
@@ -99,6 +173,14 @@ func (c Color) String() string {
 		return "white"
 	case BLACK:
 		return "black"
+	case RED:
+		return "red"
+	case BLUE:
+		return "blue"
+	case YELLOW:
+		return "yellow"
+	case GREEN:
+		return "green"
 	}
 	return "invalid"
 }
@@ -107,6 +189,16 @@ func (c Color) MarshalJSON() ([]byte, error) {
 	return json.Marshal(c.String())
 }
 
+func (c *Color) UnmarshalJSON(data []byte) error {
+	for _, color := range []Color{NO_COLOR, BLACK, WHITE, RED, BLUE, YELLOW, GREEN} {
+		if color.String() == strings.Trim(string(data), "\"") {
+			*c = color
+			return nil
+		}
+	}
+	return errors.New("invalid string value for Color")
+}
+
 type MoveType int
 
 const (
@@ -120,6 +212,11 @@ const (
 	PROMOTION
 	PROMOTION_CAPTURE
 	EN_PASSANT
+	RIDE
+	RIDE_CAPTURE
+	HOP
+	HOP_CAPTURE
+	DROP
 )
 
 func (m MoveType) String() string {
@@ -144,6 +241,16 @@ func (m MoveType) String() string {
 		return "promotion_capture"
 	case EN_PASSANT:
 		return "en_passant"
+	case RIDE:
+		return "ride"
+	case RIDE_CAPTURE:
+		return "ride_capture"
+	case HOP:
+		return "hop"
+	case HOP_CAPTURE:
+		return "hop_capture"
+	case DROP:
+		return "drop"
 	}
 	return "invalid"
 }
@@ -160,7 +267,7 @@ func (t *MoveType) UnmarshalJSON(data []byte) error {
 		*t = JUMP
 		return nil
 	case JUMP_CAPTURE.String():
-		*t = JUMP
+		*t = JUMP_CAPTURE
 		return nil
 	case PAWN_DOUBLE_PUSH.String():
 		*t = PAWN_DOUBLE_PUSH
@@ -174,9 +281,27 @@ func (t *MoveType) UnmarshalJSON(data []byte) error {
 	case PROMOTION.String():
 		*t = PROMOTION
 		return nil
+	case PROMOTION_CAPTURE.String():
+		*t = PROMOTION_CAPTURE
+		return nil
 	case EN_PASSANT.String():
 		*t = EN_PASSANT
 		return nil
+	case RIDE.String():
+		*t = RIDE
+		return nil
+	case RIDE_CAPTURE.String():
+		*t = RIDE_CAPTURE
+		return nil
+	case HOP.String():
+		*t = HOP
+		return nil
+	case HOP_CAPTURE.String():
+		*t = HOP_CAPTURE
+		return nil
+	case DROP.String():
+		*t = DROP
+		return nil
 	}
 	return errors.New("invalid string value for MoveType")
 }
@@ -190,22 +315,36 @@ func (m MoveType) MarshalText() ([]byte, error) {
 }
 
 type Move struct {
+	// Source is the position of the moving piece, unused by DROP moves.
 	Source      Position `json:"source"`
 	Destination Position `json:"destination"`
 	MoveType    MoveType `json:"move_type"`
+	// PromotionPieceType is the PieceType a pawn is promoted to,
+	// only used by PROMOTION and PROMOTION_CAPTURE moves.
+	PromotionPieceType PieceType `json:"promotion_piece_type,omitempty"`
+	// DropPieceType is the PieceType dropped from the reserve, only used by DROP moves.
+	DropPieceType PieceType `json:"drop_piece_type,omitempty"`
 }
 
 type MoveMap = map[MoveType][]Position
 
 func NewMoveMap() MoveMap {
 	return map[MoveType][]Position{
-		NORMAL:           make([]Position, 0),
-		CAPTURE:          make([]Position, 0),
-		JUMP:             make([]Position, 0),
-		JUMP_CAPTURE:     make([]Position, 0),
-		PAWN_DOUBLE_PUSH: make([]Position, 0),
-		KINGSIDE_CASTLE:  make([]Position, 0),
-		QUEENSIDE_CASTLE: make([]Position, 0),
+		NORMAL:            make([]Position, 0),
+		CAPTURE:           make([]Position, 0),
+		JUMP:              make([]Position, 0),
+		JUMP_CAPTURE:      make([]Position, 0),
+		PAWN_DOUBLE_PUSH:  make([]Position, 0),
+		KINGSIDE_CASTLE:   make([]Position, 0),
+		QUEENSIDE_CASTLE:  make([]Position, 0),
+		EN_PASSANT:        make([]Position, 0),
+		PROMOTION:         make([]Position, 0),
+		PROMOTION_CAPTURE: make([]Position, 0),
+		RIDE:              make([]Position, 0),
+		RIDE_CAPTURE:      make([]Position, 0),
+		HOP:               make([]Position, 0),
+		HOP_CAPTURE:       make([]Position, 0),
+		DROP:              make([]Position, 0),
 	}
 }
 
@@ -278,21 +417,90 @@ type Position struct {
 	File int `json:"file"`
 }
 
+// Topology is how the edges of a board connect, pieces moving off a wrapping edge re-enter on the opposite one.
+type Topology string
+
+const (
+	// TopologyFlat is a board whose edges don't wrap.
+	TopologyFlat Topology = ""
+	// TopologyCylinder is a board whose files wrap around, the a-file is next to the last file.
+	TopologyCylinder Topology = "cylinder"
+	// TopologyTorus is a board whose files and ranks both wrap around.
+	TopologyTorus Topology = "torus"
+)
+
 type Bounds struct {
 	RankCount int `json:"rank"`
 	FileCount int `json:"file"`
+	// Voids are the squares masked off the board, pieces can never stand on them.
+	Voids []Position `json:"voids,omitempty"`
+	// Topology is how the edges of the board connect, flat if empty.
+	// Pieces, move filters and Boards follow the Topology of the Bounds they are created with.
+	Topology Topology `json:"topology,omitempty"`
+}
+
+// NewCrossBounds returns square Bounds of the provided size with
+// the corners of the provided size masked off, e.g. the cross-shaped four-player board.
+func NewCrossBounds(size, corner int) Bounds {
+	bounds := Bounds{RankCount: size, FileCount: size}
+	for rank := 0; rank < size; rank++ {
+		for file := 0; file < size; file++ {
+			isCornerRank := rank < corner || rank >= size-corner
+			isCornerFile := file < corner || file >= size-corner
+			if isCornerRank && isCornerFile {
+				bounds.Voids = append(bounds.Voids, Position{Rank: rank, File: file})
+			}
+		}
+	}
+	return bounds
 }
 
 func (b Bounds) IsInboundsPosition(position Position) bool {
 	return position.Rank >= 0 &&
 		position.File >= 0 &&
 		position.Rank < b.RankCount &&
-		position.File < b.FileCount
+		position.File < b.FileCount &&
+		!b.IsVoid(position)
+}
+
+// IsVoid returns true if the Position is masked off the board.
+func (b Bounds) IsVoid(position Position) bool {
+	for _, void := range b.Voids {
+		if void == position {
+			return true
+		}
+	}
+	return false
+}
+
+// Wraps returns true if any edge of the board wraps around.
+func (b Bounds) Wraps() bool {
+	return b.Topology == TopologyCylinder || b.Topology == TopologyTorus
+}
+
+// Wrap returns the Position brought back onto the board across its wrapping edges,
+// the rank or file is unchanged if it doesn't wrap.
+func (b Bounds) Wrap(position Position) Position {
+	if b.Wraps() && b.FileCount > 0 {
+		position.File = (position.File%b.FileCount + b.FileCount) % b.FileCount
+	}
+	if b.Topology == TopologyTorus && b.RankCount > 0 {
+		position.Rank = (position.Rank%b.RankCount + b.RankCount) % b.RankCount
+	}
+	return position
+}
+
+// StepInDirection returns a Position one move in the direction from the source Position,
+// wrapped across the edges of the board.
+func (b Bounds) StepInDirection(source Position, direction Direction) Position {
+	return b.Wrap(StepInDirection(source, direction))
 }
 
 type TurnState struct {
 	Active    Color
 	TurnOrder []Color
+	// FirstColor is the Color that starts each full move, the active Color when the turn is first passed.
+	FirstColor Color
 }
 
 func (t *TurnState) GetActivePlayer() Color {
@@ -300,16 +508,130 @@ func (t *TurnState) GetActivePlayer() Color {
 }
 
 func (t *TurnState) PassTurn() {
+	if t.FirstColor == NO_COLOR {
+		t.FirstColor = t.Active
+	}
 	t.Active = t.TurnOrder[0]
 	t.TurnOrder = append(t.TurnOrder[1:], t.TurnOrder[0])
 }
 
+// GetColors returns the Colors still playing in the order they move, starting with the active player.
+func (t *TurnState) GetColors() []Color {
+	colors := []Color{t.Active}
+	for _, color := range t.TurnOrder {
+		if color != t.Active {
+			colors = append(colors, color)
+		}
+	}
+	return colors
+}
+
+// RemoveColor removes the Color from the turn order,
+// the turn passes to the next player if the Color was the active player
+// and the next player starts each full move if the Color was the first Color.
+func (t *TurnState) RemoveColor(color Color) {
+	if t.FirstColor == NO_COLOR {
+		t.FirstColor = t.Active
+	}
+	if t.FirstColor == color {
+		colors := t.GetColors()
+		for i, c := range colors {
+			if c == color {
+				t.FirstColor = colors[(i+1)%len(colors)]
+			}
+		}
+	}
+
+	turnOrder := []Color{}
+	for _, c := range t.TurnOrder {
+		if c != color {
+			turnOrder = append(turnOrder, c)
+		}
+	}
+	t.TurnOrder = turnOrder
+
+	if t.Active == color && len(turnOrder) > 0 {
+		t.PassTurn()
+	}
+}
+
+// MoveCounter is used to count the moves played on a Board.
+type MoveCounter struct {
+	// HalfmoveClock is the number of moves since the last capture or pawn move.
+	HalfmoveClock int `json:"halfmove_clock"`
+	// FullmoveNumber starts at 1 and is incremented each time the turn wraps back to the first color.
+	FullmoveNumber int `json:"fullmove_number"`
+}
+
+// NewDefaultMoveCounter creates a MoveCounter for the start of a game.
+func NewDefaultMoveCounter() MoveCounter {
+	return MoveCounter{
+		HalfmoveClock:  0,
+		FullmoveNumber: 1,
+	}
+}
+
+// UpdateMoveCounter updates the halfmove clock after the provided piece made a move.
+func (m *MoveCounter) UpdateMoveCounter(piece *Piece, isCapture bool) {
+	if piece.PieceType == PAWN || isCapture {
+		m.HalfmoveClock = 0
+	} else {
+		m.HalfmoveClock += 1
+	}
+}
+
 type EndStateType string
 
 const (
-	EndStateNone      EndStateType = "none"
-	EndStateCheckmate EndStateType = "checkmate"
-	EndStateStalemate EndStateType = "stalemate"
+	EndStateNone                 EndStateType = "none"
+	EndStateCheckmate            EndStateType = "checkmate"
+	EndStateStalemate            EndStateType = "stalemate"
+	EndStateFiftyMoveRule        EndStateType = "fifty_move_rule"
+	EndStateSeventyFiveMoveRule  EndStateType = "seventy_five_move_rule"
+	EndStateThreefoldRepetition  EndStateType = "threefold_repetition"
+	EndStateFivefoldRepetition   EndStateType = "fivefold_repetition"
+	EndStateInsufficientMaterial EndStateType = "insufficient_material"
+	EndStateKingOfTheHill        EndStateType = "king_of_the_hill"
+	EndStateThreeCheck           EndStateType = "three_check"
+	EndStateKingExploded         EndStateType = "king_exploded"
+	EndStateNoMovesLeft          EndStateType = "no_moves_left"
+	EndStateLastPlayerStanding   EndStateType = "last_player_standing"
+)
+
+const (
+	// fiftyMoveRuleHalfmoves is the halfmove clock a draw can be claimed at.
+	fiftyMoveRuleHalfmoves = 100
+	// seventyFiveMoveRuleHalfmoves is the halfmove clock the game is drawn at.
+	seventyFiveMoveRuleHalfmoves = 150
+)
+
+// IsDraw returns true if the EndStateType ends the game without a winner.
+func (e EndStateType) IsDraw() bool {
+	switch e {
+	case EndStateStalemate,
+		EndStateFiftyMoveRule,
+		EndStateSeventyFiveMoveRule,
+		EndStateThreefoldRepetition,
+		EndStateFivefoldRepetition,
+		EndStateInsufficientMaterial:
+		return true
+	default:
+		return false
+	}
+}
+
+// HasWinner returns true if the EndStateType ends the game with a winner.
+func (e EndStateType) HasWinner() bool {
+	return e != EndStateNone && !e.IsDraw()
+}
+
+// CheckState is used to represent if the active player is in check.
+type CheckState string
+
+const (
+	CheckStateNone      CheckState = "none"
+	CheckStateCheck     CheckState = "check"
+	CheckStateCheckmate CheckState = "checkmate"
 )
 
 type GameEndState struct {
@@ -318,122 +640,242 @@ type GameEndState struct {
 	Loser  Color
 }
 
-type builderOption = func(c *Builder)
+type BuilderOption = func(c *Builder)
 
 type Builder struct {
-	bounds             Bounds
-	castlingState      *CastlingState
-	moveApplicator     *MoveApplicator
-	moveFilter         *MoveFilter
-	illegalStateFilter *IllegalStateFilter
-	gameboardState     GameboardState
-	turnState          *TurnState
-	gameEndState       GameEndState
+	bounds                   Bounds
+	castlingState            *CastlingState
+	enPassantState           *EnPassantState
+	repetitionState          *RepetitionState
+	checkCountState          *CheckCountState
+	reserveState             *ReserveState
+	eliminationState         *EliminationState
+	moveApplicator           *MoveApplicator
+	moveFilter               *MoveFilter
+	illegalStateFilter       *IllegalStateFilter
+	insufficientMaterialRule *InsufficientMaterialRule
+	winCondition             *WinCondition
+	compulsoryCaptureRule    *CompulsoryCaptureRule
+	royalKings               bool
+	gameboardState           GameboardState
+	turnState                *TurnState
+	moveCounter              MoveCounter
+	gameEndState             GameEndState
 }
 
 func NewBuilder() *Builder {
-	bounds := Bounds{RankCount: 8, FileCount: 8}
-	castlingState := NewDefaultCastlingState()
 	turnState := &TurnState{
 		Active:    WHITE,
 		TurnOrder: []Color{BLACK, WHITE},
 	}
-	return &Builder{
-		bounds:        bounds,
-		castlingState: castlingState,
-		moveApplicator: NewMoveApplicator(
-			&SinglePieceMoveApplicator{},
-			&KingsideCastleMoveApplicator{},
-			&QueensideCastleMoveApplicator{},
-			&PromotionMoveApplicator{Bounds: bounds},
-		),
-		moveFilter: NewMoveFilter(
-			&FilterOutOfBounds{Bounds: bounds},
-			&FilterPieceCollision{},
-			&FilterFriendlyCapture{},
-			&FilterInvalidPawnDoublePush{},
-			&FilterIllegalKingsideCastle{
-				CastlingState: castlingState,
-			},
-			&FilterIllegalQueensideCastle{
-				CastlingState: castlingState,
-			},
-			&FilterIllegalPromotion{},
-			&FilterIllegalPromotionCapture{},
-		),
+	builder := &Builder{
+		enPassantState:  NewDefaultEnPassantState(),
+		repetitionState: NewDefaultRepetitionState(),
 		illegalStateFilter: NewIllegalStateFilter(
 			&IllegalCheckStateFilter{
 				TurnState: turnState,
 			},
 		),
-		gameboardState: NewGameboardState(bounds, GameboardState{}),
-		turnState:      turnState,
+		insufficientMaterialRule: NewInsufficientMaterialRule(
+			&ClassicInsufficientMaterialRule{},
+		),
+		royalKings:  true,
+		turnState:   turnState,
+		moveCounter: NewDefaultMoveCounter(),
 		gameEndState: GameEndState{
 			EndStateType: EndStateNone,
 			Winner:       NO_COLOR,
 			Loser:        NO_COLOR,
 		},
 	}
+	builder.setBounds(Bounds{RankCount: 8, FileCount: 8})
+	return builder
 }
 
-func WithBounds(bounds Bounds) builderOption {
+// setBounds sets the Bounds of the Builder along with the default rules that depend on them,
+// the castling rules, the promotion and double push ranks, the move filters and the empty gameboard.
+func (c *Builder) setBounds(bounds Bounds) {
+	castlingState := NewCastlingState(NewDefaultCastlingRules(bounds)...)
+	promotionRanks := NewDefaultPromotionRanks(bounds)
+	doublePushRanks := NewDefaultDoublePushRanks(bounds)
+
+	c.bounds = bounds
+	c.castlingState = castlingState
+	c.moveApplicator = NewMoveApplicator(
+		&SinglePieceMoveApplicator{},
+		&KingsideCastleMoveApplicator{CastlingState: castlingState},
+		&QueensideCastleMoveApplicator{CastlingState: castlingState},
+		&PromotionMoveApplicator{Bounds: bounds},
+		&EnPassantMoveApplicator{EnPassantState: c.enPassantState},
+	)
+	c.moveFilter = NewMoveFilter(
+		&FilterOutOfBounds{Bounds: bounds},
+		&FilterPieceCollision{Bounds: bounds},
+		&FilterFriendlyCapture{},
+		&FilterInvalidPawnDoublePush{
+			DoublePushRanks: doublePushRanks,
+		},
+		&FilterIllegalEnPassant{
+			EnPassantState: c.enPassantState,
+		},
+		&FilterIllegalKingsideCastle{
+			CastlingState: castlingState,
+		},
+		&FilterIllegalQueensideCastle{
+			CastlingState: castlingState,
+		},
+		&FilterIllegalPromotion{
+			PromotionRanks: promotionRanks,
+		},
+		&FilterIllegalPromotionCapture{
+			PromotionRanks: promotionRanks,
+		},
+		&FilterMissingPromotion{
+			PromotionRanks: promotionRanks,
+		},
+		&FilterIllegalPromotionPieceType{
+			PieceTypes: DefaultPromotionPieceTypes(),
+		},
+	)
+	c.gameboardState = NewGameboardState(bounds, GameboardState{})
+}
+
+// WithBounds sets the Bounds along with the default rules that depend on them,
+// options replacing those rules must come after it.
+func WithBounds(bounds Bounds) BuilderOption {
 	return func(c *Builder) {
-		c.bounds = bounds
+		c.setBounds(bounds)
 	}
 }
 
-func WithCastlingState(castlingState *CastlingState) builderOption {
+// WithTopology sets the Topology of the Bounds along with the default rules that depend on them,
+// options replacing those rules must come after it. Pieces follow the Topology of the Bounds they are created with.
+func WithTopology(topology Topology) BuilderOption {
+	return func(c *Builder) {
+		bounds := c.bounds
+		bounds.Topology = topology
+		c.setBounds(bounds)
+	}
+}
+
+func WithCastlingState(castlingState *CastlingState) BuilderOption {
 	return func(c *Builder) {
 		c.castlingState = castlingState
 	}
 }
 
-func WithMoveApplicator(moveApplicator *MoveApplicator) builderOption {
+func WithEnPassantState(enPassantState *EnPassantState) BuilderOption {
+	return func(c *Builder) {
+		c.enPassantState = enPassantState
+	}
+}
+
+func WithRepetitionState(repetitionState *RepetitionState) BuilderOption {
+	return func(c *Builder) {
+		c.repetitionState = repetitionState
+	}
+}
+
+func WithCheckCountState(checkCountState *CheckCountState) BuilderOption {
+	return func(c *Builder) {
+		c.checkCountState = checkCountState
+	}
+}
+
+func WithReserveState(reserveState *ReserveState) BuilderOption {
+	return func(c *Builder) {
+		c.reserveState = reserveState
+	}
+}
+
+func WithEliminationState(eliminationState *EliminationState) BuilderOption {
+	return func(c *Builder) {
+		c.eliminationState = eliminationState
+	}
+}
+
+func WithMoveApplicator(moveApplicator *MoveApplicator) BuilderOption {
 	return func(c *Builder) {
 		c.moveApplicator = moveApplicator
 	}
 }
 
-func WithMoveFilter(moveFilter *MoveFilter) builderOption {
+func WithMoveFilter(moveFilter *MoveFilter) BuilderOption {
 	return func(c *Builder) {
 		c.moveFilter = moveFilter
 	}
 }
 
-func WithIllegalStateFilter(illegalStateFilter *IllegalStateFilter) builderOption {
+func WithIllegalStateFilter(illegalStateFilter *IllegalStateFilter) BuilderOption {
 	return func(c *Builder) {
 		c.illegalStateFilter = illegalStateFilter
 	}
 }
 
-func WithGameboardState(state GameboardState) builderOption {
+func WithInsufficientMaterialRule(insufficientMaterialRule *InsufficientMaterialRule) BuilderOption {
+	return func(c *Builder) {
+		c.insufficientMaterialRule = insufficientMaterialRule
+	}
+}
+
+func WithWinCondition(winCondition *WinCondition) BuilderOption {
+	return func(c *Builder) {
+		c.winCondition = winCondition
+	}
+}
+
+func WithCompulsoryCaptureRule(compulsoryCaptureRule *CompulsoryCaptureRule) BuilderOption {
+	return func(c *Builder) {
+		c.compulsoryCaptureRule = compulsoryCaptureRule
+	}
+}
+
+// WithRoyalKings sets whether kings are royal, kings that aren't can't be checked or checkmated.
+func WithRoyalKings(royalKings bool) BuilderOption {
+	return func(c *Builder) {
+		c.royalKings = royalKings
+	}
+}
+
+func WithGameboardState(state GameboardState) BuilderOption {
 	return func(c *Builder) {
 		c.gameboardState = NewGameboardState(c.bounds, state)
 	}
 }
 
-func WithTurnState(state *TurnState) builderOption {
+func WithTurnState(state *TurnState) BuilderOption {
 	return func(c *Builder) {
 		c.turnState = state
 	}
 }
 
-func Build(options ...builderOption) *Board {
+func Build(options ...BuilderOption) *Board {
 	builder := NewBuilder()
 	for _, option := range options {
 		option(builder)
 	}
 	board := &Board{
-		Bounds:             builder.bounds,
-		MoveApplicator:     builder.moveApplicator,
-		MoveFilter:         builder.moveFilter,
-		IllegalStateFilter: builder.illegalStateFilter,
-		CastlingState:      builder.castlingState,
-		GameboardState:     builder.gameboardState,
-		TurnState:          builder.turnState,
-		GameEndState:       builder.gameEndState,
+		Bounds:                   builder.bounds,
+		MoveApplicator:           builder.moveApplicator,
+		MoveFilter:               builder.moveFilter,
+		IllegalStateFilter:       builder.illegalStateFilter,
+		InsufficientMaterialRule: builder.insufficientMaterialRule,
+		WinCondition:             builder.winCondition,
+		CompulsoryCaptureRule:    builder.compulsoryCaptureRule,
+		CastlingState:            builder.castlingState,
+		EnPassantState:           builder.enPassantState,
+		RepetitionState:          builder.repetitionState,
+		CheckCountState:          builder.checkCountState,
+		ReserveState:             builder.reserveState,
+		EliminationState:         builder.eliminationState,
+		GameboardState:           builder.gameboardState,
+		TurnState:                builder.turnState,
+		MoveCounter:              builder.moveCounter,
+		GameEndState:             builder.gameEndState,
+		royalKings:               builder.royalKings,
 	}
 	board.updateMoves()
+	board.recordPosition()
 	return board
 }
 
@@ -442,10 +884,21 @@ type Board struct {
 	*MoveApplicator
 	*MoveFilter
 	*IllegalStateFilter
+	*InsufficientMaterialRule
+	*WinCondition
+	*CompulsoryCaptureRule
 	*CastlingState
+	*EnPassantState
+	*RepetitionState
+	*CheckCountState
+	*ReserveState
+	*EliminationState
 	GameboardState
 	*TurnState
+	MoveCounter
 	GameEndState
+	// royalKings is true if kings can be checked and checkmated.
+	royalKings bool
 }
 
 // GetState returns a GameboardState for the Board.
@@ -453,25 +906,53 @@ func (b *Board) GetState() GameboardState {
 	return b.GameboardState
 }
 
+// GetGameEndState returns the GameEndState of the Board.
+func (b *Board) GetGameEndState() GameEndState {
+	return b.GameEndState
+}
+
+// GetBounds returns the Bounds of the Board, including the squares masked off it.
+func (b *Board) GetBounds() Bounds {
+	return b.Bounds
+}
+
 // HandleMove handles a Move submitted by the client.
 func (b *Board) HandleMove(move Move) error {
+	if move.MoveType == DROP {
+		return b.handleDrop(move)
+	}
+
 	// Check if there is a piece at the source position.
 	sourcePiece := b.GameboardState[move.Source.Rank][move.Source.File]
 	if sourcePiece == nil {
 		return errPieceNotFound
 	}
 
+	// Verify the piece belongs to the active player.
+	if sourcePiece.Color != b.GetActivePlayer() {
+		return errNotActivePlayer(sourcePiece.Color)
+	}
+
 	// Verify move is an available move.
 	isAvailableMove := sourcePiece.IsAvailableMove(move)
 	if !isAvailableMove {
 		return errMoveNotAllowed
 	}
 
+	// Verify move passes the move filters with all of its fields,
+	// available moves don't include details such as the promotion piece.
+	if !b.IsLegalMove(move, b.GameboardState) {
+		return errMoveNotAllowed
+	}
+
 	// Update the castle flags if necessary.
 	if b.CastlingState != nil {
 		b.UpdateCastleState(move, b.GameboardState)
 	}
 
+	// Check if the move captures a piece.
+	capturedPiece := b.GetCapturedPiece(move)
+
 	// Update the board state.
 	updatedState, moveErr := b.ApplyMove(move, b.GameboardState)
 	if moveErr != nil {
@@ -479,25 +960,130 @@ func (b *Board) HandleMove(move Move) error {
 	}
 	b.GameboardState = updatedState
 
+	// Move the captured piece to the capturer's reserve.
+	if b.ReserveState != nil {
+		b.updateReserve(sourcePiece.Color, move, capturedPiece)
+	}
+
+	// Update the en passant target if necessary.
+	if b.EnPassantState != nil {
+		b.UpdateEnPassantState(move)
+	}
+
+	// Update the move counters.
+	b.UpdateMoveCounter(sourcePiece, capturedPiece != nil)
+
+	b.endTurn(sourcePiece.Color)
+
+	return nil
+}
+
+// handleDrop handles a DROP Move of a piece from the active player's reserve.
+func (b *Board) handleDrop(move Move) error {
+	// Verify the piece can be dropped on the destination.
+	if b.ReserveState == nil || !b.IsAvailableDrop(move) {
+		return errMoveNotAllowed
+	}
+
+	// Update the board state.
+	updatedState, moveErr := b.ApplyMove(move, b.GameboardState)
+	if moveErr != nil {
+		return moveErr
+	}
+	b.GameboardState = updatedState
+
+	// Take the dropped piece from the reserve.
+	color := b.GetActivePlayer()
+	b.RemoveFromReserve(color, move.DropPieceType)
+
+	// A drop never allows an en passant capture.
+	if b.EnPassantState != nil {
+		b.UpdateEnPassantState(move)
+	}
+
+	// Update the move counters.
+	b.UpdateMoveCounter(b.GameboardState[move.Destination.Rank][move.Destination.File], false)
+
+	b.endTurn(color)
+
+	return nil
+}
+
+// endTurn passes the turn after the Color has moved and updates the state depending on the new position.
+func (b *Board) endTurn(color Color) {
 	// Pass the turn.
 	b.PassTurn()
 
 	// Update the available moves for each piece.
 	b.updateMoves()
 
+	// Count the resulting position for repetitions.
+	b.recordPosition()
+
+	// Count the check given by the move.
+	b.updateCheckCounts(color)
+
+	// Knock out the players who can't continue.
+	if b.EliminationState != nil {
+		b.eliminatePlayers()
+	}
+
+	// Count the full move once the turn wraps back to the first color.
+	if b.Active == b.FirstColor {
+		b.FullmoveNumber += 1
+	}
+
 	// Check for game ending.
-	switch b.checkGameEnd() {
-	case EndStateCheckmate:
-		b.GameEndState.EndStateType = EndStateCheckmate
-		b.Winner = b.TurnState.TurnOrder[0]
-		b.Loser = b.TurnState.Active
-	case EndStateStalemate:
-		b.GameEndState.EndStateType = EndStateStalemate
+	b.GameEndState = b.checkGameEnd()
+}
+
+// ClaimDraw ends the game in a draw if the active player is allowed to claim one.
+func (b *Board) ClaimDraw() error {
+	if b.EndStateType != EndStateNone {
+		return errGameEnded
+	}
+
+	switch {
+	case b.HalfmoveClock >= fiftyMoveRuleHalfmoves:
+		b.GameEndState.EndStateType = EndStateFiftyMoveRule
+	case b.getRepetitions() >= threefoldRepetitions:
+		b.GameEndState.EndStateType = EndStateThreefoldRepetition
+	default:
+		return errDrawNotClaimable
 	}
 
 	return nil
 }
 
+// GetCapturedPiece returns the Piece the provided move would capture, nil if none.
+func (b *Board) GetCapturedPiece(move Move) *Piece {
+	// A castling king may land on its own square or its rook's square and drops never capture.
+	if move.MoveType == KINGSIDE_CASTLE || move.MoveType == QUEENSIDE_CASTLE || move.MoveType == DROP {
+		return nil
+	}
+	if move.MoveType == EN_PASSANT && b.EnPassantState != nil && b.EnPassantState.Captured != nil {
+		return b.GameboardState[b.EnPassantState.Captured.Rank][b.EnPassantState.Captured.File]
+	}
+	return b.GameboardState[move.Destination.Rank][move.Destination.File]
+}
+
+// GetCheckState returns the CheckState of the active player.
+func (b *Board) GetCheckState() CheckState {
+	switch {
+	case b.EndStateType == EndStateCheckmate:
+		return CheckStateCheckmate
+	case b.isInCheck(b.GetActivePlayer(), b.GameboardState, b.getAvailableMoves()):
+		return CheckStateCheck
+	default:
+		return CheckStateNone
+	}
+}
+
+// isCaptureMove returns true if the provided move captures a piece.
+func (b *Board) isCaptureMove(move Move) bool {
+	return b.GetCapturedPiece(move) != nil
+}
+
 // updateMoves updates all the pieces moves by applying filters and state checks
 func (b *Board) updateMoves() {
 	// Update the available moves for each piece.
@@ -505,8 +1091,16 @@ func (b *Board) updateMoves() {
 	filtered := b.filterAvailableMoveMap(b.GameboardState, possibleMoves, b.legalMoveFilterPredicate)
 	filtered = b.filterAvailableMoveMap(b.GameboardState, filtered, b.legalCastlePredicate)
 	filtered = b.filterAvailableMoveMap(b.GameboardState, filtered, b.legalGameboardStatePredicate)
+	if b.CompulsoryCaptureRule != nil {
+		filtered = b.FilterCompulsoryCaptures(b.GetActivePlayer(), b.GameboardState, filtered)
+	}
 
-	b.setAvailableMoves(possibleMoves, b.GameboardState)
+	b.setAvailableMoves(filtered, b.GameboardState)
+
+	// Update the drops available from the active player's reserve.
+	if b.ReserveState != nil {
+		b.AvailableDrops = b.generateLegalDrops(b.GetActivePlayer(), b.GameboardState)
+	}
 }
 
 // filterAvailableMoveMap filters moves in the provided AvailableMoveMap with the provided predicate.
@@ -560,13 +1154,40 @@ func (b *Board) generatePossibleMoves(state GameboardState) AvailableMoveMap {
 		state,
 		func(source Position, piece *Piece) {
 			if piece != nil {
-				availableMoveMap[source.Rank][source.File] = piece.GenerateMoves(source)
+				availableMoveMap[source.Rank][source.File] = b.wrapMoveMap(source, piece.GenerateMoves(source))
+				if b.CastlingState != nil {
+					JoinMoveMaps(availableMoveMap[source.Rank][source.File], b.GenerateCastleMoves(source, piece))
+				}
 			}
 		},
 	)
 	return availableMoveMap
 }
 
+// wrapMoveMap returns the MoveMap with its destinations wrapped across the edges of the Board,
+// leaving out the source and destinations reached more than once.
+func (b *Board) wrapMoveMap(source Position, moveMap MoveMap) MoveMap {
+	if !b.Wraps() {
+		return moveMap
+	}
+
+	wrappedMoveMap := NewMoveMap()
+	for moveType, destinations := range moveMap {
+		seen := map[Position]bool{source: true}
+		wrappedDestinations := make([]Position, 0, len(destinations))
+		for _, destination := range destinations {
+			destination = b.Wrap(destination)
+			if seen[destination] {
+				continue
+			}
+			seen[destination] = true
+			wrappedDestinations = append(wrappedDestinations, destination)
+		}
+		wrappedMoveMap[moveType] = wrappedDestinations
+	}
+	return wrappedMoveMap
+}
+
 // getAvailableMoves gets the available moves for each piece in the game.
 func (b *Board) getAvailableMoves() AvailableMoveMap {
 	availableMoveMap := NewAvailableMoveMap(b.Bounds)
@@ -601,63 +1222,35 @@ func (b *Board) legalMoveFilterPredicate(piece *Piece, move Move, state Gameboar
 	return b.IsLegalMove(move, state)
 }
 
-// legalCastlePredicate returns true if the provided castle move doesn't move through check.
+// legalCastlePredicate returns true if the provided castle move doesn't start in or move through check.
 func (b *Board) legalCastlePredicate(piece *Piece, move Move, state GameboardState) bool {
-	// move the castling king to the adjecent square that is moved through during a castle
-	var intermediateMove Move
-	switch move.MoveType {
-	case QUEENSIDE_CASTLE:
-		switch piece.Color {
-		case WHITE:
-			intermediateMove = Move{
-				Source:      move.Source,
-				Destination: Position{Rank: 0, File: 3},
-				MoveType:    NORMAL,
-			}
-		case BLACK:
-			intermediateMove = Move{
-				Source:      move.Source,
-				Destination: Position{Rank: 7, File: 3},
-				MoveType:    NORMAL,
-			}
-		default:
-			return true
-		}
-	case KINGSIDE_CASTLE:
-		switch piece.Color {
-		case WHITE:
-			intermediateMove = Move{
-				Source:      move.Source,
-				Destination: Position{Rank: 0, File: 5},
-				MoveType:    NORMAL,
-			}
-		case BLACK:
-			intermediateMove = Move{
-				Source:      move.Source,
-				Destination: Position{Rank: 0, File: 6},
-				MoveType:    NORMAL,
-			}
-		default:
-			return true
-		}
-	default:
+	if move.MoveType != KINGSIDE_CASTLE && move.MoveType != QUEENSIDE_CASTLE {
 		return true
 	}
 
-	// validate the king is not in check in this position
-	copiedState := CopyGameboardState(state)
-	copiedState, err := b.ApplyMove(intermediateMove, copiedState)
-	if err != nil {
+	rule := b.getCastlingRule(move.MoveType, piece.Color)
+	if rule == nil {
 		return false
 	}
 
-	potentialNextTurnMoves := b.filterAvailableMoveMap(
-		copiedState,
-		b.generatePossibleMoves(copiedState),
-		b.legalMoveFilterPredicate,
-	)
+	// validate the king is not in check on each square it starts on or moves through
+	for _, square := range rule.SafeSquares {
+		copiedState := CopyGameboardState(state)
+		copiedState[rule.KingSource.Rank][rule.KingSource.File] = nil
+		copiedState[square.Rank][square.File] = piece
 
-	return b.IsLegalState(piece.Color, copiedState, potentialNextTurnMoves)
+		potentialNextTurnMoves := b.filterAvailableMoveMap(
+			copiedState,
+			b.generatePossibleMoves(copiedState),
+			b.legalMoveFilterPredicate,
+		)
+
+		if !b.IsLegalState(piece.Color, copiedState, potentialNextTurnMoves) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // legalGameboardStatePredicate returns true if the provided move results in a legal board state.
@@ -687,43 +1280,98 @@ func (b *Board) forEachPiece(state GameboardState, fn func(position Position, pi
 }
 
 // checkGameEnd checks if the game has ended.
-// If the active player has no moves they have lost.
-func (b *Board) checkGameEnd() EndStateType {
-	activePlayerHasMove := false
+// The last player standing once the others are eliminated has won,
+// a player who meets a win condition has won, if the active player has no moves or drops they have lost,
+// the game is drawn once the halfmove clock reaches the seventy-five-move rule,
+// the position occurs for the fifth time or neither player has enough material to mate.
+func (b *Board) checkGameEnd() GameEndState {
+	if b.EliminationState != nil {
+		if endState, ok := b.checkLastPlayerStanding(); ok {
+			return endState
+		}
+	}
+
+	if b.WinCondition != nil {
+		if endStateType, winner, ok := b.CheckWin(b.GameboardState); ok {
+			return GameEndState{
+				EndStateType: endStateType,
+				Winner:       winner,
+				Loser:        b.getOpponent(winner),
+			}
+		}
+	}
+
+	activePlayerHasMove := b.activePlayerHasMove()
+	activePlayerIsInCheck := b.isInCheck(
+		b.GetActivePlayer(),
+		b.GameboardState,
+		b.getAvailableMoves(),
+	)
+
+	switch {
+	case !activePlayerHasMove && activePlayerIsInCheck:
+		return GameEndState{
+			EndStateType: EndStateCheckmate,
+			Winner:       b.TurnState.TurnOrder[0],
+			Loser:        b.TurnState.Active,
+		}
+	case !activePlayerHasMove && !activePlayerIsInCheck:
+		return GameEndState{EndStateType: EndStateStalemate}
+	case b.HalfmoveClock >= seventyFiveMoveRuleHalfmoves:
+		return GameEndState{EndStateType: EndStateSeventyFiveMoveRule}
+	case b.getRepetitions() >= fivefoldRepetitions:
+		return GameEndState{EndStateType: EndStateFivefoldRepetition}
+	case b.InsufficientMaterialRule != nil && b.IsInsufficientMaterial(b.GameboardState):
+		return GameEndState{EndStateType: EndStateInsufficientMaterial}
+	default:
+		return GameEndState{EndStateType: EndStateNone}
+	}
+}
+
+// activePlayerHasMove returns true if any of the active player's pieces has a move
+// or a piece in their reserve can be dropped.
+func (b *Board) activePlayerHasMove() bool {
+	hasMove := false
 	b.forEachPiece(
 		b.GameboardState,
 		func(position Position, piece *Piece) {
-			if !activePlayerHasMove &&
+			if !hasMove &&
 				piece != nil &&
 				piece.Color == b.GetActivePlayer() {
 				for _, moveList := range piece.AvailableMoves {
 					if len(moveList) != 0 {
-						activePlayerHasMove = true
+						hasMove = true
 					}
 				}
 			}
 		},
 	)
 
-	activePlayerIsInCheck := isColorInCheck(
-		b.GetActivePlayer(),
-		b.GameboardState,
-		b.getAvailableMoves(),
-	)
+	// Pieces in the reserve may still be dropped.
+	if b.ReserveState != nil && b.HasAvailableDrop() {
+		hasMove = true
+	}
 
-	switch {
-	case !activePlayerHasMove && activePlayerIsInCheck:
-		return EndStateCheckmate
-	case !activePlayerHasMove && !activePlayerIsInCheck:
-		return EndStateStalemate
-	default:
-		return EndStateNone
+	return hasMove
+}
+
+// getOpponent returns the Color that loses when the provided Color wins,
+// the active player unless the provided Color is the one to move.
+func (b *Board) getOpponent(color Color) Color {
+	if color == b.GetActivePlayer() {
+		return b.TurnState.TurnOrder[0]
 	}
+	return b.GetActivePlayer()
+}
+
+// isInCheck returns true if the provided color is in check on the Board, never if kings aren't royal.
+func (b *Board) isInCheck(color Color, state GameboardState, availableMoveMap AvailableMoveMap) bool {
+	return b.royalKings && isColorInCheck(color, state, availableMoveMap)
 }
 
 // isColorInCheck returns true if the provided color is in check.
 func isColorInCheck(color Color, state GameboardState, availableMoveMap AvailableMoveMap) bool {
-	return !anyPosition(
+	return anyPosition(
 		color,
 		state,
 		predicateAttackingEnemyKing(
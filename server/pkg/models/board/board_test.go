@@ -1,6 +1,7 @@
 package board
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -296,6 +297,31 @@ func TestKingMoves(t *testing.T) {
 	}
 }
 
+func TestKingCaptures(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	classicBoard := Build(
+		WithBounds(bounds),
+		WithGameboardState(
+			GameboardState{
+				0: {4: NewKing(WHITE)},
+				1: {
+					3: NewKnight(BLACK),
+					4: NewPawn(WHITE),
+					5: NewPawn(BLACK),
+				},
+				3: {5: NewRook(BLACK, bounds)},
+				7: {4: NewKing(BLACK)},
+			},
+		),
+	)
+
+	availableMoves := classicBoard.GameboardState[0][4].AvailableMoves
+	assert.Contains(t, availableMoves[CAPTURE], Position{Rank: 1, File: 3})
+	// Friendly pieces and protected pieces can't be captured.
+	assert.NotContains(t, availableMoves[CAPTURE], Position{Rank: 1, File: 4})
+	assert.NotContains(t, availableMoves[CAPTURE], Position{Rank: 1, File: 5})
+}
+
 func TestRookMoves(t *testing.T) {
 	bounds := Bounds{RankCount: 8, FileCount: 8}
 	classicBoard := Build(
@@ -409,6 +435,102 @@ func TestBishopMoves(t *testing.T) {
 	}
 }
 
+func TestAvailableMovesFiltered(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	classicBoard := Build(
+		WithBounds(bounds),
+		WithGameboardState(
+			GameboardState{
+				0: {
+					0: NewRook(WHITE, bounds),
+					4: NewKing(WHITE),
+				},
+				1: {
+					0: NewPawn(WHITE),
+					4: NewRook(WHITE, bounds),
+				},
+				7: {4: NewRook(BLACK, bounds)},
+			},
+		),
+	)
+
+	tests := []struct {
+		name                 string
+		position             Position
+		expectedMissingMoves MoveMap
+	}{
+		{
+			name:     "Rook can't move through a friendly piece.",
+			position: Position{Rank: 0, File: 0},
+			expectedMissingMoves: MoveMap{
+				NORMAL: []Position{{Rank: 2, File: 0}},
+			},
+		},
+		{
+			name:     "Pinned rook can't leave the king exposed.",
+			position: Position{Rank: 1, File: 4},
+			expectedMissingMoves: MoveMap{
+				NORMAL: []Position{{Rank: 1, File: 3}, {Rank: 1, File: 7}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			availableMoves := classicBoard.GameboardState[tc.position.Rank][tc.position.File].AvailableMoves
+			for moveType, missingMovesByType := range tc.expectedMissingMoves {
+				for _, move := range missingMovesByType {
+					assert.NotContains(t, availableMoves[moveType], move)
+				}
+			}
+		})
+	}
+}
+
+func TestArchbishopMoves(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	classicBoard := Build(
+		WithBounds(bounds),
+		WithGameboardState(
+			GameboardState{
+				3: {3: NewArchbishop(WHITE, bounds)},
+				5: {4: NewPawn(BLACK)},
+				6: {6: NewPawn(BLACK)},
+			},
+		),
+	)
+
+	availableMoves := classicBoard.GameboardState[3][3].AvailableMoves
+	assert.Contains(t, availableMoves[NORMAL], Position{Rank: 0, File: 0})
+	assert.Contains(t, availableMoves[NORMAL], Position{Rank: 5, File: 5})
+	assert.Contains(t, availableMoves[CAPTURE], Position{Rank: 6, File: 6})
+	assert.Contains(t, availableMoves[JUMP], Position{Rank: 5, File: 2})
+	assert.Contains(t, availableMoves[JUMP_CAPTURE], Position{Rank: 5, File: 4})
+	assert.NotContains(t, availableMoves[NORMAL], Position{Rank: 3, File: 4})
+}
+
+func TestChancellorMoves(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	classicBoard := Build(
+		WithBounds(bounds),
+		WithGameboardState(
+			GameboardState{
+				3: {3: NewChancellor(WHITE, bounds)},
+				5: {4: NewPawn(BLACK)},
+				6: {3: NewPawn(BLACK)},
+			},
+		),
+	)
+
+	availableMoves := classicBoard.GameboardState[3][3].AvailableMoves
+	assert.Contains(t, availableMoves[NORMAL], Position{Rank: 3, File: 7})
+	assert.Contains(t, availableMoves[NORMAL], Position{Rank: 5, File: 3})
+	assert.Contains(t, availableMoves[CAPTURE], Position{Rank: 6, File: 3})
+	assert.Contains(t, availableMoves[JUMP], Position{Rank: 1, File: 2})
+	assert.Contains(t, availableMoves[JUMP_CAPTURE], Position{Rank: 5, File: 4})
+	assert.NotContains(t, availableMoves[NORMAL], Position{Rank: 4, File: 4})
+}
+
 func TestHandleMove(t *testing.T) {
 	bounds := Bounds{RankCount: 8, FileCount: 8}
 	testCases := []struct {
@@ -524,6 +646,12 @@ func TestHandleMove(t *testing.T) {
 							4: NewKing(WHITE),
 							7: NewRook(WHITE, bounds),
 						},
+						1: {
+							5: NewPawn(WHITE),
+						},
+						6: {
+							5: NewPawn(BLACK),
+						},
 						7: {
 							4: NewKing(BLACK),
 							7: NewRook(BLACK, bounds),
@@ -539,6 +667,12 @@ func TestHandleMove(t *testing.T) {
 							6: NewKing(WHITE),
 							5: NewRook(WHITE, bounds),
 						},
+						1: {
+							5: NewPawn(WHITE),
+						},
+						6: {
+							5: NewPawn(BLACK),
+						},
 						7: {
 							6: NewKing(BLACK),
 							5: NewRook(BLACK, bounds),
@@ -553,13 +687,24 @@ func TestHandleMove(t *testing.T) {
 						&FilterOutOfBounds{Bounds: bounds},
 						&FilterPieceCollision{},
 						&FilterFriendlyCapture{},
-						&FilterInvalidPawnDoublePush{},
+						&FilterInvalidPawnDoublePush{
+							DoublePushRanks: NewDefaultDoublePushRanks(bounds),
+						},
 						&FilterIllegalKingsideCastle{
 							createCastlingState(false, false, false, false),
 						},
 						&FilterIllegalQueensideCastle{
 							createCastlingState(false, false, false, false),
 						},
+						&FilterIllegalPromotion{
+							PromotionRanks: NewDefaultPromotionRanks(bounds),
+						},
+						&FilterIllegalPromotionCapture{
+							PromotionRanks: NewDefaultPromotionRanks(bounds),
+						},
+						&FilterMissingPromotion{
+							PromotionRanks: NewDefaultPromotionRanks(bounds),
+						},
 					),
 				),
 			),
@@ -587,6 +732,12 @@ func TestHandleMove(t *testing.T) {
 							0: NewRook(WHITE, bounds),
 							4: NewKing(WHITE),
 						},
+						1: {
+							3: NewPawn(WHITE),
+						},
+						6: {
+							3: NewPawn(BLACK),
+						},
 						7: {
 							0: NewRook(BLACK, bounds),
 							4: NewKing(BLACK),
@@ -602,6 +753,12 @@ func TestHandleMove(t *testing.T) {
 							2: NewKing(WHITE),
 							3: NewRook(WHITE, bounds),
 						},
+						1: {
+							3: NewPawn(WHITE),
+						},
+						6: {
+							3: NewPawn(BLACK),
+						},
 						7: {
 							2: NewKing(BLACK),
 							3: NewRook(BLACK, bounds),
@@ -616,13 +773,24 @@ func TestHandleMove(t *testing.T) {
 						&FilterOutOfBounds{Bounds: bounds},
 						&FilterPieceCollision{},
 						&FilterFriendlyCapture{},
-						&FilterInvalidPawnDoublePush{},
+						&FilterInvalidPawnDoublePush{
+							DoublePushRanks: NewDefaultDoublePushRanks(bounds),
+						},
 						&FilterIllegalKingsideCastle{
 							createCastlingState(false, false, false, false),
 						},
 						&FilterIllegalQueensideCastle{
 							createCastlingState(false, false, false, false),
 						},
+						&FilterIllegalPromotion{
+							PromotionRanks: NewDefaultPromotionRanks(bounds),
+						},
+						&FilterIllegalPromotionCapture{
+							PromotionRanks: NewDefaultPromotionRanks(bounds),
+						},
+						&FilterMissingPromotion{
+							PromotionRanks: NewDefaultPromotionRanks(bounds),
+						},
 					),
 				),
 			),
@@ -696,3 +864,1012 @@ func TestHandleMove(t *testing.T) {
 		}
 	}
 }
+
+func TestHandleMoveNotActivePlayer(t *testing.T) {
+	testCases := []struct {
+		name        string
+		turnState   *TurnState
+		move        Move
+		expectedErr error
+	}{
+		{
+			name:      "Active player's piece.",
+			turnState: &TurnState{Active: WHITE, TurnOrder: []Color{BLACK, WHITE}},
+			move: Move{
+				Source:      Position{Rank: 1, File: 0},
+				Destination: Position{Rank: 2, File: 0},
+				MoveType:    NORMAL,
+			},
+		},
+		{
+			name:      "Waiting player's piece.",
+			turnState: &TurnState{Active: WHITE, TurnOrder: []Color{BLACK, WHITE}},
+			move: Move{
+				Source:      Position{Rank: 6, File: 0},
+				Destination: Position{Rank: 5, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedErr: errNotActivePlayer(BLACK),
+		},
+		{
+			name:      "Black to move.",
+			turnState: &TurnState{Active: BLACK, TurnOrder: []Color{WHITE, BLACK}},
+			move: Move{
+				Source:      Position{Rank: 1, File: 0},
+				Destination: Position{Rank: 2, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedErr: errNotActivePlayer(WHITE),
+		},
+		{
+			name:      "Piece of a player outside the turn order.",
+			turnState: &TurnState{Active: RED, TurnOrder: []Color{BLUE, RED}},
+			move: Move{
+				Source:      Position{Rank: 1, File: 0},
+				Destination: Position{Rank: 2, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedErr: errNotActivePlayer(WHITE),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			board := Build(
+				WithTurnState(tc.turnState),
+				WithGameboardState(
+					GameboardState{
+						1: {0: NewPawn(WHITE)},
+						6: {0: NewPawn(BLACK)},
+					},
+				),
+			)
+			assert.Equal(t, tc.expectedErr, board.HandleMove(tc.move))
+		})
+	}
+}
+
+func TestEnPassantMoves(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	testCases := []struct {
+		name          string
+		moves         []Move
+		initialBoard  *Board
+		expectedBoard *Board
+		expectedErr   error
+	}{
+		{
+			name: "en passant after double push",
+			moves: []Move{
+				{
+					Source:      Position{Rank: 6, File: 3},
+					Destination: Position{Rank: 4, File: 3},
+					MoveType:    PAWN_DOUBLE_PUSH,
+				},
+				{
+					Source:      Position{Rank: 4, File: 4},
+					Destination: Position{Rank: 5, File: 3},
+					MoveType:    EN_PASSANT,
+				},
+			},
+			initialBoard: Build(
+				WithBounds(bounds),
+				WithTurnState(&TurnState{Active: BLACK, TurnOrder: []Color{WHITE, BLACK}}),
+				WithGameboardState(
+					GameboardState{
+						4: {
+							4: NewPawn(WHITE),
+						},
+						6: {
+							3: NewPawn(BLACK),
+						},
+					},
+				),
+			),
+			expectedBoard: Build(
+				WithBounds(bounds),
+				WithGameboardState(
+					GameboardState{
+						5: {
+							3: NewPawn(WHITE),
+						},
+					},
+				),
+			),
+			expectedErr: nil,
+		},
+		{
+			name: "en passant not allowed after another move",
+			moves: []Move{
+				{
+					Source:      Position{Rank: 6, File: 3},
+					Destination: Position{Rank: 4, File: 3},
+					MoveType:    PAWN_DOUBLE_PUSH,
+				},
+				{
+					Source:      Position{Rank: 1, File: 0},
+					Destination: Position{Rank: 2, File: 0},
+					MoveType:    NORMAL,
+				},
+				{
+					Source:      Position{Rank: 6, File: 0},
+					Destination: Position{Rank: 5, File: 0},
+					MoveType:    NORMAL,
+				},
+				{
+					Source:      Position{Rank: 4, File: 4},
+					Destination: Position{Rank: 5, File: 3},
+					MoveType:    EN_PASSANT,
+				},
+			},
+			initialBoard: Build(
+				WithBounds(bounds),
+				WithTurnState(&TurnState{Active: BLACK, TurnOrder: []Color{WHITE, BLACK}}),
+				WithGameboardState(
+					GameboardState{
+						1: {
+							0: NewPawn(WHITE),
+						},
+						4: {
+							4: NewPawn(WHITE),
+						},
+						6: {
+							0: NewPawn(BLACK),
+							3: NewPawn(BLACK),
+						},
+					},
+				),
+			),
+			expectedErr: errMoveNotAllowed,
+		},
+		{
+			name: "en passant exposing the king not allowed",
+			moves: []Move{
+				{
+					Source:      Position{Rank: 1, File: 4},
+					Destination: Position{Rank: 3, File: 4},
+					MoveType:    PAWN_DOUBLE_PUSH,
+				},
+				{
+					Source:      Position{Rank: 3, File: 3},
+					Destination: Position{Rank: 2, File: 4},
+					MoveType:    EN_PASSANT,
+				},
+			},
+			initialBoard: Build(
+				WithBounds(bounds),
+				WithGameboardState(
+					GameboardState{
+						1: {
+							4: NewPawn(WHITE),
+						},
+						3: {
+							0: NewKing(BLACK),
+							3: NewPawn(BLACK),
+							7: NewRook(WHITE, bounds),
+						},
+					},
+				),
+			),
+			expectedErr: errMoveNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			board := tc.initialBoard
+
+			var err error
+			for _, move := range tc.moves {
+				err = board.HandleMove(move)
+				if err != nil {
+					break
+				}
+			}
+			assert.Equal(t, tc.expectedErr, err)
+
+			if tc.expectedBoard != nil {
+				assert.True(t, reflect.DeepEqual(board.GameboardState, tc.expectedBoard.GameboardState))
+			}
+		})
+	}
+}
+
+func TestMoveUnmarshalJSON(t *testing.T) {
+	testcases := []struct {
+		name         string
+		data         string
+		expectedMove Move
+		expectErr    bool
+	}{
+		{
+			name: "Move without promotion.",
+			data: `{"source":{"rank":1,"file":1},"destination":{"rank":2,"file":1},"move_type":"normal"}`,
+			expectedMove: Move{
+				Source:      Position{Rank: 1, File: 1},
+				Destination: Position{Rank: 2, File: 1},
+				MoveType:    NORMAL,
+			},
+		},
+		{
+			name: "Promotion capture to knight.",
+			data: `{"source":{"rank":6,"file":1},"destination":{"rank":7,"file":2},"move_type":"promotion_capture","promotion_piece_type":"knight"}`,
+			expectedMove: Move{
+				Source:             Position{Rank: 6, File: 1},
+				Destination:        Position{Rank: 7, File: 2},
+				MoveType:           PROMOTION_CAPTURE,
+				PromotionPieceType: KNIGHT,
+			},
+		},
+		{
+			name: "Promotion to chancellor.",
+			data: `{"source":{"rank":6,"file":1},"destination":{"rank":7,"file":1},"move_type":"promotion","promotion_piece_type":"chancellor"}`,
+			expectedMove: Move{
+				Source:             Position{Rank: 6, File: 1},
+				Destination:        Position{Rank: 7, File: 1},
+				MoveType:           PROMOTION,
+				PromotionPieceType: CHANCELLOR,
+			},
+		},
+		{
+			name: "Drop of a knight.",
+			data: `{"destination":{"rank":2,"file":5},"move_type":"drop","drop_piece_type":"knight"}`,
+			expectedMove: Move{
+				Destination:   Position{Rank: 2, File: 5},
+				MoveType:      DROP,
+				DropPieceType: KNIGHT,
+			},
+		},
+		{
+			name:      "Invalid promotion piece.",
+			data:      `{"source":{"rank":6,"file":1},"destination":{"rank":7,"file":1},"move_type":"promotion","promotion_piece_type":"wizard"}`,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			move := Move{}
+			err := json.Unmarshal([]byte(tc.data), &move)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedMove, move)
+		})
+	}
+}
+
+func TestPromotionMoves(t *testing.T) {
+	testCases := []struct {
+		name              string
+		bounds            Bounds
+		state             GameboardState
+		move              Move
+		expectedPieceType PieceType
+	}{
+		{
+			name:   "classic board promotion to knight",
+			bounds: Bounds{RankCount: 8, FileCount: 8},
+			state: GameboardState{
+				6: {
+					0: NewPawn(WHITE),
+				},
+			},
+			move: Move{
+				Source:             Position{Rank: 6, File: 0},
+				Destination:        Position{Rank: 7, File: 0},
+				MoveType:           PROMOTION,
+				PromotionPieceType: KNIGHT,
+			},
+			expectedPieceType: KNIGHT,
+		},
+		{
+			name:   "6x6 board promotion",
+			bounds: Bounds{RankCount: 6, FileCount: 6},
+			state: GameboardState{
+				4: {
+					2: NewPawn(WHITE),
+				},
+			},
+			move: Move{
+				Source:      Position{Rank: 4, File: 2},
+				Destination: Position{Rank: 5, File: 2},
+				MoveType:    PROMOTION,
+			},
+			expectedPieceType: QUEEN,
+		},
+		{
+			name:   "10x8 board promotion capture to rook",
+			bounds: Bounds{RankCount: 8, FileCount: 10},
+			state: GameboardState{
+				6: {
+					8: NewPawn(WHITE),
+				},
+				7: {
+					9: NewKnight(BLACK),
+				},
+			},
+			move: Move{
+				Source:             Position{Rank: 6, File: 8},
+				Destination:        Position{Rank: 7, File: 9},
+				MoveType:           PROMOTION_CAPTURE,
+				PromotionPieceType: ROOK,
+			},
+			expectedPieceType: ROOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			board := Build(
+				WithBounds(tc.bounds),
+				WithMoveFilter(newTestMoveFilter(tc.bounds)),
+				WithMoveApplicator(newTestMoveApplicator(tc.bounds)),
+				WithGameboardState(tc.state),
+			)
+
+			// Pawns must promote when reaching the last rank.
+			pawnMoves := board.GameboardState[tc.move.Source.Rank][tc.move.Source.File].AvailableMoves
+			assert.Empty(t, pawnMoves[NORMAL])
+			assert.Empty(t, pawnMoves[CAPTURE])
+			assert.Contains(t, pawnMoves[tc.move.MoveType], tc.move.Destination)
+
+			err := board.HandleMove(tc.move)
+			assert.Nil(t, err)
+
+			promotedPiece := board.GameboardState[tc.move.Destination.Rank][tc.move.Destination.File]
+			assert.Equal(t, tc.expectedPieceType, promotedPiece.PieceType)
+			assert.Equal(t, Color(WHITE), promotedPiece.Color)
+		})
+	}
+}
+
+func newTestMoveFilter(bounds Bounds) *MoveFilter {
+	promotionRanks := NewDefaultPromotionRanks(bounds)
+	return NewMoveFilter(
+		&FilterOutOfBounds{Bounds: bounds},
+		&FilterPieceCollision{},
+		&FilterFriendlyCapture{},
+		&FilterIllegalPromotion{PromotionRanks: promotionRanks},
+		&FilterIllegalPromotionCapture{PromotionRanks: promotionRanks},
+		&FilterMissingPromotion{PromotionRanks: promotionRanks},
+		&FilterIllegalPromotionPieceType{PieceTypes: DefaultPromotionPieceTypes()},
+	)
+}
+
+func newTestMoveApplicator(bounds Bounds) *MoveApplicator {
+	return NewMoveApplicator(
+		&SinglePieceMoveApplicator{},
+		&PromotionMoveApplicator{Bounds: bounds},
+	)
+}
+
+func TestBuildWithBounds(t *testing.T) {
+	bounds := Bounds{RankCount: 10, FileCount: 10}
+	board := Build(
+		WithBounds(bounds),
+		WithGameboardState(
+			GameboardState{
+				0: {
+					0: NewRook(WHITE, bounds),
+					4: NewKing(WHITE),
+				},
+				1: {5: NewPawn(WHITE)},
+				8: {1: NewPawn(WHITE)},
+				9: {9: NewKing(BLACK)},
+			},
+		),
+	)
+
+	// The move filters use the bounds of the board.
+	rookMoves := board.GameboardState[0][0].AvailableMoves
+	assert.Contains(t, rookMoves[NORMAL], Position{Rank: 9, File: 0})
+
+	// The double push ranks use the bounds of the board.
+	pawnMoves := board.GameboardState[1][5].AvailableMoves
+	assert.Contains(t, pawnMoves[PAWN_DOUBLE_PUSH], Position{Rank: 3, File: 5})
+
+	// The promotion ranks use the bounds of the board.
+	promotionMoves := board.GameboardState[8][1].AvailableMoves
+	assert.Empty(t, promotionMoves[NORMAL])
+	assert.Contains(t, promotionMoves[PROMOTION], Position{Rank: 9, File: 1})
+
+	err := board.HandleMove(Move{
+		Source:             Position{Rank: 8, File: 1},
+		Destination:        Position{Rank: 9, File: 1},
+		MoveType:           PROMOTION,
+		PromotionPieceType: QUEEN,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, QUEEN, board.GameboardState[9][1].PieceType)
+}
+
+func TestBuildWithTopology(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8, Topology: TopologyCylinder}
+	board := Build(
+		WithTopology(TopologyCylinder),
+		WithGameboardState(
+			GameboardState{
+				0: {
+					2: NewPawn(WHITE),
+					6: NewRook(WHITE, bounds),
+					7: NewKing(WHITE),
+				},
+				7: {4: NewKing(BLACK)},
+			},
+		),
+	)
+	assert.Equal(t, bounds, board.GetBounds())
+
+	// The move filters use the topology of the board.
+	kingMoves := board.GameboardState[0][7].AvailableMoves
+	assert.Contains(t, kingMoves[NORMAL], Position{Rank: 0, File: 0})
+	assert.Contains(t, kingMoves[NORMAL], Position{Rank: 1, File: 0})
+
+	// The collision filter follows the ray across the edge.
+	rookMoves := board.GameboardState[0][6].AvailableMoves
+	assert.NotContains(t, rookMoves[NORMAL], Position{Rank: 0, File: 0})
+}
+
+func TestCheckGameEnd(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		fen                  string
+		move                 Move
+		expectedGameEndState GameEndState
+		expectedCheckState   CheckState
+	}{
+		{
+			name: "Back rank checkmate.",
+			fen:  "6k1/5ppp/8/8/8/8/8/R3K3 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateCheckmate,
+				Winner:       WHITE,
+				Loser:        BLACK,
+			},
+			expectedCheckState: CheckStateCheckmate,
+		},
+		{
+			name: "Queen stalemate.",
+			fen:  "7k/8/6K1/8/8/8/5Q2/8 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 1, File: 5},
+				Destination: Position{Rank: 6, File: 5},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateStalemate,
+			},
+			expectedCheckState: CheckStateNone,
+		},
+		{
+			name: "Check is not the end of the game.",
+			fen:  "6k1/8/8/8/8/8/8/R3K3 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateNone,
+			},
+			expectedCheckState: CheckStateCheck,
+		},
+		{
+			name: "Seventy-five-move rule.",
+			fen:  "4k3/8/8/8/8/8/8/R3K3 w - - 149 90",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 0, File: 1},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateSeventyFiveMoveRule,
+			},
+			expectedCheckState: CheckStateNone,
+		},
+		{
+			name: "Checkmate on the seventy-fifth move.",
+			fen:  "6k1/5ppp/8/8/8/8/8/R3K3 w - - 149 90",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateCheckmate,
+				Winner:       WHITE,
+				Loser:        BLACK,
+			},
+			expectedCheckState: CheckStateCheckmate,
+		},
+		{
+			name: "Capturing the last rook is insufficient material.",
+			fen:  "4k3/8/8/8/8/8/3r4/2B1K3 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 0, File: 2},
+				Destination: Position{Rank: 1, File: 3},
+				MoveType:    CAPTURE,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateInsufficientMaterial,
+			},
+			expectedCheckState: CheckStateNone,
+		},
+		{
+			name: "Bishops on different square colors are sufficient material.",
+			fen:  "2b1k3/8/8/8/8/8/8/2B1K3 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 0, File: 4},
+				Destination: Position{Rank: 0, File: 5},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateNone,
+			},
+			expectedCheckState: CheckStateNone,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, Bounds{RankCount: 8, FileCount: 8})
+			assert.Nil(t, err)
+			board := Build(WithFEN(position))
+
+			assert.Nil(t, board.HandleMove(tc.move))
+			assert.Equal(t, tc.expectedGameEndState, board.GameEndState)
+			assert.Equal(t, tc.expectedCheckState, board.GetCheckState())
+		})
+	}
+}
+
+func TestCheckGameEndWithoutInsufficientMaterialRules(t *testing.T) {
+	position, err := ParseFEN("4k3/8/8/8/8/8/3r4/2B1K3 w - - 0 1", Bounds{RankCount: 8, FileCount: 8})
+	assert.Nil(t, err)
+	board := Build(
+		WithFEN(position),
+		WithInsufficientMaterialRule(NewInsufficientMaterialRule()),
+	)
+
+	assert.Nil(t, board.HandleMove(Move{
+		Source:      Position{Rank: 0, File: 2},
+		Destination: Position{Rank: 1, File: 3},
+		MoveType:    CAPTURE,
+	}))
+	assert.Equal(t, EndStateNone, board.EndStateType)
+}
+
+func TestLegalCastle(t *testing.T) {
+	testCases := []struct {
+		name                string
+		fen                 string
+		expectedDestination []Position
+	}{
+		{
+			name:                "Castle allowed.",
+			fen:                 "6k1/8/8/8/8/8/8/4K2R w K - 0 1",
+			expectedDestination: []Position{{Rank: 0, File: 6}},
+		},
+		{
+			name:                "Castle out of check not allowed.",
+			fen:                 "4r1k1/8/8/8/8/8/8/4K2R w K - 0 1",
+			expectedDestination: []Position{},
+		},
+		{
+			name:                "Castle through check not allowed.",
+			fen:                 "5rk1/8/8/8/8/8/8/4K2R w K - 0 1",
+			expectedDestination: []Position{},
+		},
+		{
+			name:                "Castle into check not allowed.",
+			fen:                 "6rk/8/8/8/8/8/8/4K2R w K - 0 1",
+			expectedDestination: []Position{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, Bounds{RankCount: 8, FileCount: 8})
+			assert.Nil(t, err)
+			board := Build(WithFEN(position))
+
+			king := board.GameboardState[0][4]
+			assert.Equal(t, tc.expectedDestination, king.AvailableMoves[KINGSIDE_CASTLE])
+		})
+	}
+}
+
+func TestGetCapturedPiece(t *testing.T) {
+	testCases := []struct {
+		name          string
+		fen           string
+		move          Move
+		expectedPiece *Piece
+	}{
+		{
+			name: "Capture.",
+			fen:  "4k3/8/8/3p4/4P3/8/8/4K3 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 3, File: 4},
+				Destination: Position{Rank: 4, File: 3},
+				MoveType:    CAPTURE,
+			},
+			expectedPiece: NewPawn(BLACK),
+		},
+		{
+			name: "En passant.",
+			fen:  "4k3/8/8/3pP3/8/8/8/4K3 w - d6 0 1",
+			move: Move{
+				Source:      Position{Rank: 4, File: 4},
+				Destination: Position{Rank: 5, File: 3},
+				MoveType:    EN_PASSANT,
+			},
+			expectedPiece: NewPawn(BLACK),
+		},
+		{
+			name: "No capture.",
+			fen:  "4k3/8/8/3pP3/8/8/8/4K3 w - d6 0 1",
+			move: Move{
+				Source:      Position{Rank: 4, File: 4},
+				Destination: Position{Rank: 5, File: 4},
+				MoveType:    NORMAL,
+			},
+			expectedPiece: nil,
+		},
+		{
+			name: "Castle onto the king's own square.",
+			fen:  "6k1/8/8/8/8/8/8/1R4KR w K - 0 1",
+			move: Move{
+				Source:      Position{Rank: 0, File: 6},
+				Destination: Position{Rank: 0, File: 6},
+				MoveType:    KINGSIDE_CASTLE,
+			},
+			expectedPiece: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, Bounds{RankCount: 8, FileCount: 8})
+			assert.Nil(t, err)
+			board := Build(WithFEN(position))
+
+			piece := board.GetCapturedPiece(tc.move)
+			if tc.expectedPiece == nil {
+				assert.Nil(t, piece)
+				return
+			}
+			assert.Equal(t, tc.expectedPiece.Color, piece.Color)
+			assert.Equal(t, tc.expectedPiece.PieceType, piece.PieceType)
+		})
+	}
+}
+
+func TestClaimDraw(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		fen                  string
+		move                 *Move
+		expectErr            bool
+		expectedEndStateType EndStateType
+	}{
+		{
+			name:                 "Fifty-move rule.",
+			fen:                  "4k3/8/8/8/8/8/8/R3K3 w - - 100 60",
+			expectedEndStateType: EndStateFiftyMoveRule,
+		},
+		{
+			name:                 "Not enough moves.",
+			fen:                  "4k3/8/8/8/8/8/8/R3K3 w - - 99 60",
+			expectErr:            true,
+			expectedEndStateType: EndStateNone,
+		},
+		{
+			name: "Game already ended.",
+			fen:  "6k1/5ppp/8/8/8/8/8/R3K3 w - - 120 60",
+			move: &Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectErr:            true,
+			expectedEndStateType: EndStateCheckmate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, Bounds{RankCount: 8, FileCount: 8})
+			assert.Nil(t, err)
+			board := Build(WithFEN(position))
+			if tc.move != nil {
+				assert.Nil(t, board.HandleMove(*tc.move))
+			}
+
+			err = board.ClaimDraw()
+			if tc.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tc.expectedEndStateType, board.GetGameEndState().EndStateType)
+		})
+	}
+}
+
+func TestCrossBounds(t *testing.T) {
+	bounds := NewCrossBounds(14, 3)
+	testCases := []struct {
+		name             string
+		position         Position
+		expectedInbounds bool
+	}{
+		{
+			name:             "Corner is masked off.",
+			position:         Position{Rank: 0, File: 0},
+			expectedInbounds: false,
+		},
+		{
+			name:             "Inner corner square is masked off.",
+			position:         Position{Rank: 2, File: 11},
+			expectedInbounds: false,
+		},
+		{
+			name:             "Square next to the corner.",
+			position:         Position{Rank: 2, File: 3},
+			expectedInbounds: true,
+		},
+		{
+			name:             "Center square.",
+			position:         Position{Rank: 6, File: 7},
+			expectedInbounds: true,
+		},
+		{
+			name:             "Square off the board.",
+			position:         Position{Rank: 14, File: 7},
+			expectedInbounds: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedInbounds, bounds.IsInboundsPosition(tc.position))
+		})
+	}
+}
+
+func TestNewGameboardStateVoids(t *testing.T) {
+	bounds := Bounds{
+		RankCount: 2,
+		FileCount: 2,
+		Voids:     []Position{{Rank: 1, File: 1}},
+	}
+	state := NewGameboardState(
+		bounds,
+		GameboardState{
+			0: {0: NewKing(WHITE)},
+			1: {1: NewKing(BLACK)},
+		},
+	)
+
+	assert.Equal(
+		t,
+		GameboardState{
+			0: {0: NewKing(WHITE), 1: nil},
+			1: {0: nil},
+		},
+		state,
+	)
+}
+
+func TestTopologyMoves(t *testing.T) {
+	cylinder := Bounds{RankCount: 8, FileCount: 8, Topology: TopologyCylinder}
+	torus := Bounds{RankCount: 8, FileCount: 8, Topology: TopologyTorus}
+	smallTorus := Bounds{RankCount: 4, FileCount: 4, Topology: TopologyTorus}
+	testCases := []struct {
+		name          string
+		bounds        Bounds
+		state         GameboardState
+		position      Position
+		moveType      MoveType
+		expectedMoves []Position
+	}{
+		{
+			name:   "Rook ray wraps around the files of a cylinder until blocked.",
+			bounds: cylinder,
+			state: GameboardState{
+				0: {2: NewPawn(WHITE), 6: NewRook(WHITE, cylinder)},
+			},
+			position: Position{Rank: 0, File: 6},
+			moveType: NORMAL,
+			expectedMoves: []Position{
+				{Rank: 1, File: 6}, {Rank: 2, File: 6}, {Rank: 3, File: 6}, {Rank: 4, File: 6},
+				{Rank: 5, File: 6}, {Rank: 6, File: 6}, {Rank: 7, File: 6},
+				{Rank: 0, File: 7}, {Rank: 0, File: 0}, {Rank: 0, File: 1},
+				{Rank: 0, File: 5}, {Rank: 0, File: 4}, {Rank: 0, File: 3},
+			},
+		},
+		{
+			name:   "Rook ray stops when it returns to its origin on a torus.",
+			bounds: smallTorus,
+			state: GameboardState{
+				1: {1: NewRook(WHITE, smallTorus)},
+			},
+			position: Position{Rank: 1, File: 1},
+			moveType: NORMAL,
+			expectedMoves: []Position{
+				{Rank: 2, File: 1}, {Rank: 3, File: 1}, {Rank: 0, File: 1},
+				{Rank: 1, File: 2}, {Rank: 1, File: 3}, {Rank: 1, File: 0},
+			},
+		},
+		{
+			name:   "Knight leaps across the edges of a torus.",
+			bounds: torus,
+			state: GameboardState{
+				0: {0: NewKnight(WHITE)},
+			},
+			position: Position{Rank: 0, File: 0},
+			moveType: JUMP,
+			expectedMoves: []Position{
+				{Rank: 2, File: 1}, {Rank: 2, File: 7}, {Rank: 1, File: 2}, {Rank: 1, File: 6},
+				{Rank: 7, File: 2}, {Rank: 7, File: 6}, {Rank: 6, File: 1}, {Rank: 6, File: 7},
+			},
+		},
+		{
+			name:   "Knight doesn't leap across the ranks of a cylinder.",
+			bounds: cylinder,
+			state: GameboardState{
+				0: {0: NewKnight(WHITE)},
+			},
+			position: Position{Rank: 0, File: 0},
+			moveType: JUMP,
+			expectedMoves: []Position{
+				{Rank: 2, File: 1}, {Rank: 2, File: 7}, {Rank: 1, File: 2}, {Rank: 1, File: 6},
+			},
+		},
+		{
+			name:   "Bishop ray wraps around the ranks of a torus until blocked.",
+			bounds: torus,
+			state: GameboardState{
+				7: {2: NewBishop(WHITE, torus)},
+				2: {5: NewPawn(WHITE)},
+				3: {6: NewPawn(WHITE)},
+			},
+			position: Position{Rank: 7, File: 2},
+			moveType: NORMAL,
+			expectedMoves: []Position{
+				{Rank: 0, File: 3}, {Rank: 1, File: 4},
+				{Rank: 0, File: 1}, {Rank: 1, File: 0}, {Rank: 2, File: 7},
+				{Rank: 6, File: 3}, {Rank: 5, File: 4}, {Rank: 4, File: 5},
+				{Rank: 6, File: 1}, {Rank: 5, File: 0}, {Rank: 4, File: 7},
+			},
+		},
+		{
+			name:   "Bishop ray stops when it returns to its origin on a torus.",
+			bounds: smallTorus,
+			state: GameboardState{
+				1: {1: NewBishop(WHITE, smallTorus)},
+			},
+			position: Position{Rank: 1, File: 1},
+			moveType: NORMAL,
+			expectedMoves: []Position{
+				{Rank: 2, File: 2}, {Rank: 3, File: 3}, {Rank: 0, File: 0},
+				{Rank: 2, File: 0}, {Rank: 0, File: 2},
+			},
+		},
+		{
+			name:   "Nightrider rides across the ranks of a torus until it returns to its origin.",
+			bounds: smallTorus,
+			state: GameboardState{
+				0: {0: NewPiece(WHITE, KNIGHT, NewRiderMoveGenerator(1, 2, smallTorus))},
+			},
+			position: Position{Rank: 0, File: 0},
+			moveType: RIDE,
+			expectedMoves: []Position{
+				{Rank: 1, File: 2}, {Rank: 2, File: 0}, {Rank: 3, File: 2},
+				{Rank: 2, File: 1}, {Rank: 0, File: 2}, {Rank: 2, File: 3},
+			},
+		},
+		{
+			name:   "Pawn captures across the edge of a cylinder.",
+			bounds: cylinder,
+			state: GameboardState{
+				1: {0: NewPawn(WHITE)},
+				2: {7: NewPawn(BLACK)},
+			},
+			position:      Position{Rank: 1, File: 0},
+			moveType:      CAPTURE,
+			expectedMoves: []Position{{Rank: 2, File: 7}},
+		},
+		{
+			name:   "King steps across the edge of a cylinder.",
+			bounds: cylinder,
+			state: GameboardState{
+				0: {7: NewKing(WHITE)},
+			},
+			position: Position{Rank: 0, File: 7},
+			moveType: NORMAL,
+			expectedMoves: []Position{
+				{Rank: 0, File: 6}, {Rank: 1, File: 6}, {Rank: 1, File: 7}, {Rank: 1, File: 0}, {Rank: 0, File: 0},
+			},
+		},
+		{
+			name:   "Nightrider rides around a cylinder.",
+			bounds: cylinder,
+			state: GameboardState{
+				0: {6: NewPiece(WHITE, KNIGHT, NewRiderMoveGenerator(1, 2, cylinder))},
+				2: {2: NewPawn(WHITE)},
+			},
+			position: Position{Rank: 0, File: 6},
+			moveType: RIDE,
+			expectedMoves: []Position{
+				{Rank: 1, File: 0}, {Rank: 1, File: 4},
+				{Rank: 2, File: 7}, {Rank: 4, File: 0}, {Rank: 6, File: 1},
+				{Rank: 2, File: 5}, {Rank: 4, File: 4}, {Rank: 6, File: 3},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			topologyBoard := Build(
+				WithBounds(tc.bounds),
+				WithMoveFilter(NewMoveFilter(
+					&FilterOutOfBounds{Bounds: tc.bounds},
+					&FilterPieceCollision{Bounds: tc.bounds},
+					&FilterFriendlyCapture{},
+				)),
+				WithGameboardState(tc.state),
+			)
+			piece := topologyBoard.GetState()[tc.position.Rank][tc.position.File]
+			assert.ElementsMatch(t, tc.expectedMoves, piece.AvailableMoves[tc.moveType])
+		})
+	}
+}
+
+func TestTurnStateRemoveColor(t *testing.T) {
+	testCases := []struct {
+		name               string
+		color              Color
+		expectedActive     Color
+		expectedTurnOrder  []Color
+		expectedFirstColor Color
+	}{
+		{
+			name:               "Remove the active player.",
+			color:              RED,
+			expectedActive:     BLUE,
+			expectedTurnOrder:  []Color{YELLOW, GREEN, BLUE},
+			expectedFirstColor: BLUE,
+		},
+		{
+			name:               "Remove a waiting player.",
+			color:              YELLOW,
+			expectedActive:     RED,
+			expectedTurnOrder:  []Color{BLUE, GREEN, RED},
+			expectedFirstColor: RED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			turnState := &TurnState{
+				Active:    RED,
+				TurnOrder: []Color{BLUE, YELLOW, GREEN, RED},
+			}
+			turnState.RemoveColor(tc.color)
+			assert.Equal(t, tc.expectedActive, turnState.Active)
+			assert.Equal(t, tc.expectedTurnOrder, turnState.TurnOrder)
+			assert.Equal(t, tc.expectedFirstColor, turnState.FirstColor)
+			assert.Equal(t, append([]Color{tc.expectedActive}, tc.expectedTurnOrder[:2]...), turnState.GetColors())
+		})
+	}
+}
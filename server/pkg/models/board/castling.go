@@ -1,26 +1,148 @@
 package board
 
+// CastlingRule describes a castling move by the squares it uses.
+type CastlingRule struct {
+	MoveType        MoveType `json:"move_type"`
+	Color           Color    `json:"color"`
+	KingSource      Position `json:"king_source"`
+	KingDestination Position `json:"king_destination"`
+	RookSource      Position `json:"rook_source"`
+	RookDestination Position `json:"rook_destination"`
+	// EmptySquares must be empty apart from the castling king and rook.
+	EmptySquares []Position `json:"empty_squares"`
+	// SafeSquares must not be attacked, the king starts on or moves through them.
+	SafeSquares []Position `json:"safe_squares"`
+}
+
+// NewCastlingRule creates a CastlingRule on the provided rank,
+// the empty and safe squares are derived from the king and rook files.
+func NewCastlingRule(
+	moveType MoveType,
+	color Color,
+	rank int,
+	kingFile int,
+	rookFile int,
+	kingDestinationFile int,
+	rookDestinationFile int,
+) CastlingRule {
+	rule := CastlingRule{
+		MoveType:        moveType,
+		Color:           color,
+		KingSource:      Position{Rank: rank, File: kingFile},
+		KingDestination: Position{Rank: rank, File: kingDestinationFile},
+		RookSource:      Position{Rank: rank, File: rookFile},
+		RookDestination: Position{Rank: rank, File: rookDestinationFile},
+		EmptySquares:    []Position{},
+		SafeSquares:     []Position{},
+	}
+
+	// Every square between the outermost squares of the king and rook must be empty.
+	files := []int{kingFile, rookFile, kingDestinationFile, rookDestinationFile}
+	for file := minFile(files...); file <= maxFile(files...); file++ {
+		if file != kingFile && file != rookFile {
+			rule.EmptySquares = append(rule.EmptySquares, Position{Rank: rank, File: file})
+		}
+	}
+
+	// The king can't castle out of, through or into check.
+	for file := minFile(kingFile, kingDestinationFile); file <= maxFile(kingFile, kingDestinationFile); file++ {
+		rule.SafeSquares = append(rule.SafeSquares, Position{Rank: rank, File: file})
+	}
+
+	return rule
+}
+
+// minFile returns the lowest of the provided files.
+func minFile(files ...int) int {
+	lowest := files[0]
+	for _, file := range files[1:] {
+		if file < lowest {
+			lowest = file
+		}
+	}
+	return lowest
+}
+
+// maxFile returns the highest of the provided files.
+func maxFile(files ...int) int {
+	highest := files[0]
+	for _, file := range files[1:] {
+		if file > highest {
+			highest = file
+		}
+	}
+	return highest
+}
+
+// NewStandardCastlingRules creates the kingside and queenside CastlingRules of both players,
+// the king lands on the second file from the edge with the rook on its inner side.
+func NewStandardCastlingRules(bounds Bounds, kingFile, queensideRookFile, kingsideRookFile int) []CastlingRule {
+	rules := []CastlingRule{}
+	for _, player := range []struct {
+		color Color
+		rank  int
+	}{
+		{WHITE, 0},
+		{BLACK, bounds.RankCount - 1},
+	} {
+		rules = append(
+			rules,
+			NewCastlingRule(
+				KINGSIDE_CASTLE,
+				player.color,
+				player.rank,
+				kingFile,
+				kingsideRookFile,
+				bounds.FileCount-2,
+				bounds.FileCount-3,
+			),
+			NewCastlingRule(
+				QUEENSIDE_CASTLE,
+				player.color,
+				player.rank,
+				kingFile,
+				queensideRookFile,
+				2,
+				3,
+			),
+		)
+	}
+	return rules
+}
+
+// NewDefaultCastlingRules creates the standard CastlingRules with the king
+// on the middle file and the rooks in the corners of the provided Bounds.
+func NewDefaultCastlingRules(bounds Bounds) []CastlingRule {
+	return NewStandardCastlingRules(bounds, bounds.FileCount/2, 0, bounds.FileCount-1)
+}
+
 // CastlingState is used to represent which types of
 // castling moves are still allowed for each player.
 type CastlingState struct {
 	CastlingStateMap map[MoveType]map[Color]bool
+	CastlingRules    []CastlingRule
+}
+
+// NewCastlingState creates a new CastlingState for the provided rules,
+// all of them are initially allowed.
+func NewCastlingState(rules ...CastlingRule) *CastlingState {
+	castlingState := &CastlingState{
+		CastlingStateMap: map[MoveType]map[Color]bool{},
+		CastlingRules:    rules,
+	}
+	for _, rule := range rules {
+		if _, ok := castlingState.CastlingStateMap[rule.MoveType]; !ok {
+			castlingState.CastlingStateMap[rule.MoveType] = map[Color]bool{}
+		}
+		castlingState.CastlingStateMap[rule.MoveType][rule.Color] = true
+	}
+	return castlingState
 }
 
-// NewCastlingState creates a new CastlingState
+// NewDefaultCastlingState creates a new CastlingState for a classic 8x8 board,
 // all the values are initialized to true.
 func NewDefaultCastlingState() *CastlingState {
-	return &CastlingState{
-		CastlingStateMap: map[MoveType]map[Color]bool{
-			KINGSIDE_CASTLE: {
-				WHITE: true,
-				BLACK: true,
-			},
-			QUEENSIDE_CASTLE: {
-				WHITE: true,
-				BLACK: true,
-			},
-		},
-	}
+	return NewCastlingState(NewDefaultCastlingRules(Bounds{RankCount: 8, FileCount: 8})...)
 }
 
 // IsAllowed returns true if the provided MoveType is allowed for the player Color.
@@ -33,39 +155,101 @@ func (c *CastlingState) IsAllowed(moveType MoveType, color Color) bool {
 	return false
 }
 
-// Disallow prevents the provided player Color from making the provided MoveType.
+// UpdateCastleState disallows the castles that the provided move prevents,
+// moving the king prevents all of its castles and moving or capturing a rook prevents its castle.
 func (c *CastlingState) UpdateCastleState(move Move, state GameboardState) {
 	sourcePiece := state[move.Source.Rank][move.Source.File]
 	if sourcePiece == nil {
 		return
 	}
-	if sourcePiece.GetType() == KING {
-		switch sourcePiece.GetColor() {
-		case BLACK:
-			c.disallow(KINGSIDE_CASTLE, BLACK)
-			c.disallow(QUEENSIDE_CASTLE, BLACK)
-		case WHITE:
-			c.disallow(KINGSIDE_CASTLE, WHITE)
-			c.disallow(QUEENSIDE_CASTLE, WHITE)
+	for _, rule := range c.CastlingRules {
+		switch {
+		case sourcePiece.GetType() == KING && sourcePiece.GetColor() == rule.Color:
+			c.disallow(rule.MoveType, rule.Color)
+		case move.Source == rule.RookSource || move.Destination == rule.RookSource:
+			c.disallow(rule.MoveType, rule.Color)
 		}
-	} else if sourcePiece.GetType() == ROOK {
-		switch sourcePiece.GetColor() {
-		case WHITE:
-			if move.Source.Rank == 0 && move.Source.File == 0 {
-				c.disallow(QUEENSIDE_CASTLE, WHITE)
-			} else if move.Source.Rank == 0 && move.Source.File == 7 {
-				c.disallow(KINGSIDE_CASTLE, WHITE)
-			}
-		case BLACK:
-			if move.Source.Rank == 7 && move.Source.File == 0 {
-				c.disallow(QUEENSIDE_CASTLE, BLACK)
-			} else if move.Source.Rank == 7 && move.Source.File == 7 {
-				c.disallow(KINGSIDE_CASTLE, BLACK)
-			}
+	}
+}
+
+// GenerateCastleMoves generates the castling moves of the provided piece,
+// a king can castle by the rules that start on its position.
+func (c *CastlingState) GenerateCastleMoves(source Position, piece *Piece) MoveMap {
+	moves := map[MoveType][]Position{
+		KINGSIDE_CASTLE:  {},
+		QUEENSIDE_CASTLE: {},
+	}
+	if piece == nil || piece.GetType() != KING {
+		return moves
+	}
+	for _, rule := range c.CastlingRules {
+		if rule.Color == piece.GetColor() && rule.KingSource == source {
+			moves[rule.MoveType] = append(moves[rule.MoveType], rule.KingDestination)
 		}
 	}
+	return moves
+}
+
+// getCastlingRule returns the CastlingRule for the provided MoveType and Color, nil if there is none.
+func (c *CastlingState) getCastlingRule(moveType MoveType, color Color) *CastlingRule {
+	if c == nil {
+		return nil
+	}
+	for i := range c.CastlingRules {
+		if c.CastlingRules[i].MoveType == moveType && c.CastlingRules[i].Color == color {
+			return &c.CastlingRules[i]
+		}
+	}
+	return nil
+}
+
+// isLegalCastle returns true if the provided castle is allowed and its squares are clear.
+func (c *CastlingState) isLegalCastle(move Move, state GameboardState) bool {
+	kingPiece := state[move.Source.Rank][move.Source.File]
+	if kingPiece == nil || kingPiece.GetType() != KING {
+		return false
+	}
+
+	rule := c.getCastlingRule(move.MoveType, kingPiece.GetColor())
+	if rule == nil || !c.IsAllowed(rule.MoveType, rule.Color) {
+		return false
+	}
+	if move.Source != rule.KingSource || move.Destination != rule.KingDestination {
+		return false
+	}
+
+	rookPiece := state[rule.RookSource.Rank][rule.RookSource.File]
+	if rookPiece == nil || rookPiece.GetType() != ROOK || rookPiece.GetColor() != rule.Color {
+		return false
+	}
+
+	for _, square := range rule.EmptySquares {
+		if state[square.Rank][square.File] != nil {
+			return false
+		}
+	}
+	return true
 }
 
 func (c *CastlingState) disallow(moveType MoveType, color Color) {
-	c.CastlingStateMap[moveType][color] = false
+	if colorMap, ok := c.CastlingStateMap[moveType]; ok {
+		colorMap[color] = false
+	}
+}
+
+// applyCastle moves the king and rook of the provided rule to their destinations.
+func (r *CastlingRule) applyCastle(state GameboardState) error {
+	kingPiece := state[r.KingSource.Rank][r.KingSource.File]
+	rookPiece := state[r.RookSource.Rank][r.RookSource.File]
+	if kingPiece == nil || rookPiece == nil {
+		return errSourcePieceNotFound
+	}
+
+	// Lift both pieces first, the king may land on the rook's square.
+	state[r.KingSource.Rank][r.KingSource.File] = nil
+	state[r.RookSource.Rank][r.RookSource.File] = nil
+	state[r.KingDestination.Rank][r.KingDestination.File] = kingPiece
+	state[r.RookDestination.Rank][r.RookDestination.File] = rookPiece
+
+	return nil
 }
@@ -149,6 +149,18 @@ func TestUpdateCastleState(t *testing.T) {
 			},
 			expectedCastlingState: createCastlingState(true, true, false, true),
 		},
+		{
+			name:          "White kingside rook captured.",
+			castlingState: NewDefaultCastlingState(),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 7, File: 7},
+					Destination: Position{Rank: 0, File: 7},
+					MoveType:    CAPTURE,
+				},
+			},
+			expectedCastlingState: createCastlingState(false, true, false, true),
+		},
 		{
 			name:          "Unrelated moves.",
 			castlingState: NewDefaultCastlingState(),
@@ -195,5 +207,224 @@ func createCastlingState(whiteKingside, whiteQueenside, blackKingside, blackQuee
 				BLACK: blackQueenside,
 			},
 		},
+		CastlingRules: NewDefaultCastlingRules(Bounds{RankCount: 8, FileCount: 8}),
+	}
+}
+
+func TestNewCastlingRule(t *testing.T) {
+	testcases := []struct {
+		name         string
+		rule         CastlingRule
+		expectedRule CastlingRule
+	}{
+		{
+			name: "Classic kingside castle.",
+			rule: NewCastlingRule(KINGSIDE_CASTLE, WHITE, 0, 4, 7, 6, 5),
+			expectedRule: CastlingRule{
+				MoveType:        KINGSIDE_CASTLE,
+				Color:           WHITE,
+				KingSource:      Position{Rank: 0, File: 4},
+				KingDestination: Position{Rank: 0, File: 6},
+				RookSource:      Position{Rank: 0, File: 7},
+				RookDestination: Position{Rank: 0, File: 5},
+				EmptySquares:    []Position{{Rank: 0, File: 5}, {Rank: 0, File: 6}},
+				SafeSquares:     []Position{{Rank: 0, File: 4}, {Rank: 0, File: 5}, {Rank: 0, File: 6}},
+			},
+		},
+		{
+			name: "Classic queenside castle.",
+			rule: NewCastlingRule(QUEENSIDE_CASTLE, BLACK, 7, 4, 0, 2, 3),
+			expectedRule: CastlingRule{
+				MoveType:        QUEENSIDE_CASTLE,
+				Color:           BLACK,
+				KingSource:      Position{Rank: 7, File: 4},
+				KingDestination: Position{Rank: 7, File: 2},
+				RookSource:      Position{Rank: 7, File: 0},
+				RookDestination: Position{Rank: 7, File: 3},
+				EmptySquares:    []Position{{Rank: 7, File: 1}, {Rank: 7, File: 2}, {Rank: 7, File: 3}},
+				SafeSquares:     []Position{{Rank: 7, File: 2}, {Rank: 7, File: 3}, {Rank: 7, File: 4}},
+			},
+		},
+		{
+			name: "Rook next to the king's destination.",
+			rule: NewCastlingRule(QUEENSIDE_CASTLE, WHITE, 0, 1, 2, 2, 3),
+			expectedRule: CastlingRule{
+				MoveType:        QUEENSIDE_CASTLE,
+				Color:           WHITE,
+				KingSource:      Position{Rank: 0, File: 1},
+				KingDestination: Position{Rank: 0, File: 2},
+				RookSource:      Position{Rank: 0, File: 2},
+				RookDestination: Position{Rank: 0, File: 3},
+				EmptySquares:    []Position{{Rank: 0, File: 3}},
+				SafeSquares:     []Position{{Rank: 0, File: 1}, {Rank: 0, File: 2}},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedRule, tc.rule)
+		})
+	}
+}
+
+func TestNewDefaultCastlingRules(t *testing.T) {
+	testcases := []struct {
+		name          string
+		bounds        Bounds
+		expectedRules []CastlingRule
+	}{
+		{
+			name:   "8x8 board.",
+			bounds: Bounds{RankCount: 8, FileCount: 8},
+			expectedRules: []CastlingRule{
+				NewCastlingRule(KINGSIDE_CASTLE, WHITE, 0, 4, 7, 6, 5),
+				NewCastlingRule(QUEENSIDE_CASTLE, WHITE, 0, 4, 0, 2, 3),
+				NewCastlingRule(KINGSIDE_CASTLE, BLACK, 7, 4, 7, 6, 5),
+				NewCastlingRule(QUEENSIDE_CASTLE, BLACK, 7, 4, 0, 2, 3),
+			},
+		},
+		{
+			name:   "10x8 board.",
+			bounds: Bounds{RankCount: 8, FileCount: 10},
+			expectedRules: []CastlingRule{
+				NewCastlingRule(KINGSIDE_CASTLE, WHITE, 0, 5, 9, 8, 7),
+				NewCastlingRule(QUEENSIDE_CASTLE, WHITE, 0, 5, 0, 2, 3),
+				NewCastlingRule(KINGSIDE_CASTLE, BLACK, 7, 5, 9, 8, 7),
+				NewCastlingRule(QUEENSIDE_CASTLE, BLACK, 7, 5, 0, 2, 3),
+			},
+		},
+		{
+			name:   "6x6 board.",
+			bounds: Bounds{RankCount: 6, FileCount: 6},
+			expectedRules: []CastlingRule{
+				NewCastlingRule(KINGSIDE_CASTLE, WHITE, 0, 3, 5, 4, 3),
+				NewCastlingRule(QUEENSIDE_CASTLE, WHITE, 0, 3, 0, 2, 3),
+				NewCastlingRule(KINGSIDE_CASTLE, BLACK, 5, 3, 5, 4, 3),
+				NewCastlingRule(QUEENSIDE_CASTLE, BLACK, 5, 3, 0, 2, 3),
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedRules, NewDefaultCastlingRules(tc.bounds))
+		})
+	}
+}
+
+func TestGenerateCastleMoves(t *testing.T) {
+	castlingState := NewCastlingState(NewDefaultCastlingRules(Bounds{RankCount: 8, FileCount: 10})...)
+	testcases := []struct {
+		name          string
+		source        Position
+		piece         *Piece
+		expectedMoves MoveMap
+	}{
+		{
+			name:   "King on its starting square.",
+			source: Position{Rank: 0, File: 5},
+			piece:  NewKing(WHITE),
+			expectedMoves: MoveMap{
+				KINGSIDE_CASTLE:  {{Rank: 0, File: 8}},
+				QUEENSIDE_CASTLE: {{Rank: 0, File: 2}},
+			},
+		},
+		{
+			name:   "King away from its starting square.",
+			source: Position{Rank: 0, File: 4},
+			piece:  NewKing(WHITE),
+			expectedMoves: MoveMap{
+				KINGSIDE_CASTLE:  {},
+				QUEENSIDE_CASTLE: {},
+			},
+		},
+		{
+			name:   "King on the other player's starting square.",
+			source: Position{Rank: 7, File: 5},
+			piece:  NewKing(WHITE),
+			expectedMoves: MoveMap{
+				KINGSIDE_CASTLE:  {},
+				QUEENSIDE_CASTLE: {},
+			},
+		},
+		{
+			name:   "Rook doesn't castle.",
+			source: Position{Rank: 0, File: 9},
+			piece:  NewRook(WHITE, Bounds{RankCount: 8, FileCount: 10}),
+			expectedMoves: MoveMap{
+				KINGSIDE_CASTLE:  {},
+				QUEENSIDE_CASTLE: {},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedMoves, castlingState.GenerateCastleMoves(tc.source, tc.piece))
+		})
+	}
+}
+
+func TestCastleOnWideBoard(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 10}
+	castlingState := NewCastlingState(NewDefaultCastlingRules(bounds)...)
+	filter := NewMoveFilter(
+		&FilterIllegalKingsideCastle{CastlingState: castlingState},
+		&FilterIllegalQueensideCastle{CastlingState: castlingState},
+	)
+	moveApplicator := NewMoveApplicator(
+		&KingsideCastleMoveApplicator{CastlingState: castlingState},
+		&QueensideCastleMoveApplicator{CastlingState: castlingState},
+	)
+	state := NewGameboardState(
+		bounds,
+		GameboardState{
+			0: {
+				0: NewRook(WHITE, bounds),
+				5: NewKing(WHITE),
+				9: NewRook(WHITE, bounds),
+			},
+		},
+	)
+
+	kingsideCastle := Move{
+		Source:      Position{Rank: 0, File: 5},
+		Destination: Position{Rank: 0, File: 8},
+		MoveType:    KINGSIDE_CASTLE,
+	}
+	assert.True(t, filter.IsLegalMove(kingsideCastle, state))
+	updatedState, err := moveApplicator.ApplyMove(kingsideCastle, state)
+	assert.Nil(t, err)
+	assert.Equal(
+		t,
+		NewGameboardState(
+			bounds,
+			GameboardState{
+				0: {
+					0: NewRook(WHITE, bounds),
+					7: NewRook(WHITE, bounds),
+					8: NewKing(WHITE),
+				},
+			},
+		),
+		updatedState,
+	)
+
+	// The 8x8 destination isn't a castle on a wider board.
+	assert.False(t, filter.IsLegalMove(Move{
+		Source:      Position{Rank: 0, File: 5},
+		Destination: Position{Rank: 0, File: 6},
+		MoveType:    KINGSIDE_CASTLE,
+	}, state))
+
+	queensideCastle := Move{
+		Source:      Position{Rank: 0, File: 5},
+		Destination: Position{Rank: 0, File: 2},
+		MoveType:    QUEENSIDE_CASTLE,
 	}
+	state[0][1] = NewKnight(WHITE)
+	assert.False(t, filter.IsLegalMove(queensideCastle, state))
+	state[0][1] = nil
+	assert.True(t, filter.IsLegalMove(queensideCastle, state))
 }
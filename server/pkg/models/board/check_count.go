@@ -0,0 +1,76 @@
+package board
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CheckCountState is used to count how many checks each Color has given.
+type CheckCountState struct {
+	// Checks maps each Color to the number of checks it has given.
+	Checks map[Color]int
+}
+
+// NewDefaultCheckCountState creates a new CheckCountState with no checks given.
+func NewDefaultCheckCountState() *CheckCountState {
+	return &CheckCountState{
+		Checks: map[Color]int{WHITE: 0, BLACK: 0},
+	}
+}
+
+// RecordCheck counts a check given by the Color.
+func (c *CheckCountState) RecordCheck(color Color) {
+	c.Checks[color] += 1
+}
+
+// GetChecks returns the number of checks the Color has given.
+func (c *CheckCountState) GetChecks(color Color) int {
+	return c.Checks[color]
+}
+
+// GetCheckCounts returns the number of checks each Color has given, nil if the Board doesn't count checks.
+func (b *Board) GetCheckCounts() map[Color]int {
+	if b.CheckCountState == nil {
+		return nil
+	}
+	checkCounts := map[Color]int{}
+	for color, checks := range b.Checks {
+		checkCounts[color] = checks
+	}
+	return checkCounts
+}
+
+// updateCheckCounts counts a check given by the Color if the active player is in check.
+func (b *Board) updateCheckCounts(color Color) {
+	if b.CheckCountState == nil {
+		return
+	}
+	if b.isInCheck(b.GetActivePlayer(), b.GameboardState, b.getAvailableMoves()) {
+		b.RecordCheck(color)
+	}
+}
+
+// parseFENCheckCounts parses the check counts field of a FEN string, e.g. +1+0
+// for one check given by white and none by black.
+func parseFENCheckCounts(field string) (map[Color]int, error) {
+	counts := strings.Split(field, "+")
+	if len(counts) != 3 || counts[0] != "" {
+		return nil, fmt.Errorf("expected +white+black")
+	}
+
+	checkCounts := map[Color]int{}
+	for i, color := range []Color{WHITE, BLACK} {
+		checks, err := strconv.Atoi(counts[i+1])
+		if err != nil || checks < 0 {
+			return nil, fmt.Errorf("invalid check count %s", counts[i+1])
+		}
+		checkCounts[color] = checks
+	}
+	return checkCounts, nil
+}
+
+// fenCheckCounts returns the check counts field of the Board's FEN.
+func (b *Board) fenCheckCounts() string {
+	return fmt.Sprintf("+%d+%d", b.GetChecks(WHITE), b.GetChecks(BLACK))
+}
@@ -0,0 +1,83 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCountState(t *testing.T) {
+	checkCountState := NewDefaultCheckCountState()
+	checkCountState.RecordCheck(WHITE)
+	checkCountState.RecordCheck(WHITE)
+	checkCountState.RecordCheck(BLACK)
+
+	assert.Equal(t, 2, checkCountState.GetChecks(WHITE))
+	assert.Equal(t, 1, checkCountState.GetChecks(BLACK))
+}
+
+func TestCheckCounts(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	testCases := []struct {
+		name                string
+		fen                 string
+		move                Move
+		expectedCheckCounts map[Color]int
+		expectedFEN         string
+	}{
+		{
+			name: "White gives check.",
+			fen:  "4k3/8/8/8/8/8/8/R3K3 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedCheckCounts: map[Color]int{WHITE: 1, BLACK: 0},
+			expectedFEN:         "R3k3/8/8/8/8/8/8/4K3 b - - 1 1 +1+0",
+		},
+		{
+			name: "Black gives check.",
+			fen:  "r3k3/8/8/8/8/8/8/4K3 b - - 0 1 +1+1",
+			move: Move{
+				Source:      Position{Rank: 7, File: 0},
+				Destination: Position{Rank: 0, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedCheckCounts: map[Color]int{WHITE: 1, BLACK: 2},
+			expectedFEN:         "4k3/8/8/8/8/8/8/r3K3 w - - 1 2 +1+2",
+		},
+		{
+			name: "Quiet move.",
+			fen:  "4k3/8/8/8/8/8/8/R3K3 w - - 0 1 +1+0",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 1, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedCheckCounts: map[Color]int{WHITE: 1, BLACK: 0},
+			expectedFEN:         "4k3/8/8/8/8/8/R7/4K3 b - - 1 1 +1+0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, bounds)
+			assert.Nil(t, err)
+			board := Build(
+				WithCheckCountState(NewDefaultCheckCountState()),
+				WithFEN(position),
+			)
+
+			assert.Nil(t, board.HandleMove(tc.move))
+			assert.Equal(t, tc.expectedCheckCounts, board.GetCheckCounts())
+			assert.Equal(t, tc.expectedFEN, board.FEN())
+		})
+	}
+}
+
+func TestCheckCountsNotCounted(t *testing.T) {
+	board := Build()
+	assert.Nil(t, board.GetCheckCounts())
+	assert.Equal(t, "8/8/8/8/8/8/8/8 w KQkq - 0 1", board.FEN())
+}
@@ -16,7 +16,7 @@ func predicateAttackingEnemyKing(
 			return false
 		}
 
-		capturingMoveTypes := []MoveType{CAPTURE, JUMP_CAPTURE}
+		capturingMoveTypes := []MoveType{CAPTURE, JUMP_CAPTURE, PROMOTION_CAPTURE, RIDE_CAPTURE, HOP_CAPTURE}
 		for _, moveType := range capturingMoveTypes {
 			if movesByType, ok := moves[moveType]; ok {
 				for _, destination := range movesByType {
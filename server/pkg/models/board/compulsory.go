@@ -0,0 +1,61 @@
+package board
+
+// compulsoryCaptureMoveTypes are the MoveTypes that capture a piece.
+var compulsoryCaptureMoveTypes = map[MoveType]bool{
+	CAPTURE:           true,
+	JUMP_CAPTURE:      true,
+	RIDE_CAPTURE:      true,
+	HOP_CAPTURE:       true,
+	PROMOTION_CAPTURE: true,
+	EN_PASSANT:        true,
+}
+
+// CompulsoryCaptureRule is used to force a player to capture when any of their pieces can.
+type CompulsoryCaptureRule struct{}
+
+// FilterCompulsoryCaptures removes the moves of the Color's pieces that don't capture
+// if any of its pieces has a capture in the AvailableMoveMap.
+func (c *CompulsoryCaptureRule) FilterCompulsoryCaptures(
+	color Color,
+	state GameboardState,
+	availableMoveMap AvailableMoveMap,
+) AvailableMoveMap {
+	if !hasCapture(color, state, availableMoveMap) {
+		return availableMoveMap
+	}
+
+	for rank, files := range availableMoveMap {
+		for file, moveMap := range files {
+			piece := state[rank][file]
+			if piece == nil || piece.Color != color {
+				continue
+			}
+			captures := NewMoveMap()
+			for moveType, destinations := range moveMap {
+				if compulsoryCaptureMoveTypes[moveType] {
+					captures[moveType] = destinations
+				}
+			}
+			availableMoveMap[rank][file] = captures
+		}
+	}
+	return availableMoveMap
+}
+
+// hasCapture returns true if any of the Color's pieces has a capture in the AvailableMoveMap.
+func hasCapture(color Color, state GameboardState, availableMoveMap AvailableMoveMap) bool {
+	for rank, files := range availableMoveMap {
+		for file, moveMap := range files {
+			piece := state[rank][file]
+			if piece == nil || piece.Color != color {
+				continue
+			}
+			for moveType, destinations := range moveMap {
+				if compulsoryCaptureMoveTypes[moveType] && len(destinations) > 0 {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
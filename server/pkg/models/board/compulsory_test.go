@@ -0,0 +1,104 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterCompulsoryCaptures(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	state := NewGameboardState(
+		bounds,
+		GameboardState{
+			0: {
+				0: NewRook(WHITE, bounds),
+				7: NewRook(WHITE, bounds),
+			},
+			7: {
+				0: NewRook(BLACK, bounds),
+			},
+		},
+	)
+	testCases := []struct {
+		name                     string
+		color                    Color
+		availableMoveMap         AvailableMoveMap
+		expectedAvailableMoveMap AvailableMoveMap
+	}{
+		{
+			name:  "Non captures removed when a capture exists.",
+			color: WHITE,
+			availableMoveMap: AvailableMoveMap{
+				0: {
+					0: MoveMap{
+						NORMAL:  []Position{{Rank: 1, File: 0}},
+						CAPTURE: []Position{{Rank: 7, File: 0}},
+					},
+					7: MoveMap{
+						NORMAL: []Position{{Rank: 1, File: 7}},
+					},
+				},
+				7: {
+					0: MoveMap{
+						NORMAL: []Position{{Rank: 6, File: 0}},
+					},
+				},
+			},
+			expectedAvailableMoveMap: AvailableMoveMap{
+				0: {
+					0: MoveMap{
+						NORMAL:            []Position{},
+						CAPTURE:           []Position{{Rank: 7, File: 0}},
+						JUMP:              []Position{},
+						JUMP_CAPTURE:      []Position{},
+						PAWN_DOUBLE_PUSH:  []Position{},
+						KINGSIDE_CASTLE:   []Position{},
+						QUEENSIDE_CASTLE:  []Position{},
+						EN_PASSANT:        []Position{},
+						PROMOTION:         []Position{},
+						PROMOTION_CAPTURE: []Position{},
+						RIDE:              []Position{},
+						RIDE_CAPTURE:      []Position{},
+						HOP:               []Position{},
+						HOP_CAPTURE:       []Position{},
+						DROP:              []Position{},
+					},
+					7: NewMoveMap(),
+				},
+				7: {
+					0: MoveMap{
+						NORMAL: []Position{{Rank: 6, File: 0}},
+					},
+				},
+			},
+		},
+		{
+			name:  "Moves kept without a capture.",
+			color: BLACK,
+			availableMoveMap: AvailableMoveMap{
+				7: {
+					0: MoveMap{
+						NORMAL:  []Position{{Rank: 6, File: 0}},
+						CAPTURE: []Position{},
+					},
+				},
+			},
+			expectedAvailableMoveMap: AvailableMoveMap{
+				7: {
+					0: MoveMap{
+						NORMAL:  []Position{{Rank: 6, File: 0}},
+						CAPTURE: []Position{},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filtered := (&CompulsoryCaptureRule{}).FilterCompulsoryCaptures(tc.color, state, tc.availableMoveMap)
+			assert.Equal(t, tc.expectedAvailableMoveMap, filtered)
+		})
+	}
+}
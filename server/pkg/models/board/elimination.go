@@ -0,0 +1,82 @@
+package board
+
+// EliminationState is used to record the players knocked out of a game of more than two players.
+type EliminationState struct {
+	// Eliminated lists the eliminated Colors in the order they were eliminated.
+	Eliminated []Color
+}
+
+// NewDefaultEliminationState creates a new EliminationState with no players eliminated.
+func NewDefaultEliminationState() *EliminationState {
+	return &EliminationState{
+		Eliminated: []Color{},
+	}
+}
+
+// Eliminate records the Color as eliminated.
+func (e *EliminationState) Eliminate(color Color) {
+	e.Eliminated = append(e.Eliminated, color)
+}
+
+// IsEliminated returns true if the Color has been eliminated.
+func (e *EliminationState) IsEliminated(color Color) bool {
+	for _, eliminated := range e.Eliminated {
+		if eliminated == color {
+			return true
+		}
+	}
+	return false
+}
+
+// GetEliminated returns the eliminated Colors in the order they were eliminated,
+// nil if the Board doesn't eliminate players.
+func (b *Board) GetEliminated() []Color {
+	if b.EliminationState == nil {
+		return nil
+	}
+	return append([]Color{}, b.Eliminated...)
+}
+
+// eliminatePlayers knocks out the players who lost their king and the active player while they have no moves,
+// whether checkmated or stalemated. Eliminated players' pieces are removed and the turn passes over them.
+func (b *Board) eliminatePlayers() {
+	if b.royalKings {
+		kings := findKings(b.GameboardState)
+		for _, color := range b.GetColors() {
+			if _, ok := kings[color]; !ok && len(b.TurnOrder) > 1 {
+				b.eliminate(color)
+			}
+		}
+	}
+
+	for len(b.TurnOrder) > 1 && !b.activePlayerHasMove() {
+		b.eliminate(b.GetActivePlayer())
+	}
+}
+
+// eliminate knocks the Color out of the game and updates the moves of the remaining pieces.
+func (b *Board) eliminate(color Color) {
+	b.Eliminate(color)
+	b.RemoveColor(color)
+	b.forEachPiece(
+		b.GameboardState,
+		func(position Position, piece *Piece) {
+			if piece != nil && piece.Color == color {
+				b.GameboardState[position.Rank][position.File] = nil
+			}
+		},
+	)
+	b.updateMoves()
+}
+
+// checkLastPlayerStanding returns the GameEndState of a game won by the only player not eliminated.
+func (b *Board) checkLastPlayerStanding() (GameEndState, bool) {
+	if len(b.TurnOrder) > 1 || len(b.Eliminated) == 0 {
+		return GameEndState{}, false
+	}
+	return GameEndState{
+		EndStateType: EndStateLastPlayerStanding,
+		Winner:       b.GetActivePlayer(),
+		Loser:        b.Eliminated[len(b.Eliminated)-1],
+	}, true
+}
@@ -0,0 +1,117 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEliminationState(t *testing.T) {
+	eliminationState := NewDefaultEliminationState()
+	eliminationState.Eliminate(BLUE)
+	eliminationState.Eliminate(GREEN)
+
+	assert.Equal(t, []Color{BLUE, GREEN}, eliminationState.Eliminated)
+	assert.True(t, eliminationState.IsEliminated(BLUE))
+	assert.False(t, eliminationState.IsEliminated(RED))
+}
+
+func TestEliminationsNotKept(t *testing.T) {
+	board := Build()
+	assert.Nil(t, board.GetEliminated())
+}
+
+func TestEliminatePlayers(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	testCases := []struct {
+		name                 string
+		state                GameboardState
+		move                 Move
+		expectedActive       Color
+		expectedEliminated   []Color
+		expectedGameEndState GameEndState
+	}{
+		{
+			name: "Stalemated player is eliminated.",
+			state: GameboardState{
+				0: {0: NewKing(BLUE)},
+				2: {2: NewQueen(RED, bounds)},
+				7: {0: NewKing(RED), 7: NewKing(YELLOW)},
+			},
+			move: Move{
+				Source:      Position{Rank: 2, File: 2},
+				Destination: Position{Rank: 2, File: 1},
+				MoveType:    NORMAL,
+			},
+			expectedActive:     YELLOW,
+			expectedEliminated: []Color{BLUE},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateNone,
+			},
+		},
+		{
+			name: "Player without a king is eliminated.",
+			state: GameboardState{
+				0: {0: NewKing(BLUE)},
+				5: {7: NewRook(RED, bounds)},
+				7: {0: NewKing(RED), 7: NewKing(YELLOW)},
+			},
+			move: Move{
+				Source:      Position{Rank: 5, File: 7},
+				Destination: Position{Rank: 7, File: 7},
+				MoveType:    CAPTURE,
+			},
+			expectedActive:     BLUE,
+			expectedEliminated: []Color{YELLOW},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateNone,
+			},
+		},
+		{
+			name: "Last player standing wins.",
+			state: GameboardState{
+				0: {0: NewKing(BLUE)},
+				2: {2: NewQueen(RED, bounds)},
+				7: {0: NewKing(RED)},
+			},
+			move: Move{
+				Source:      Position{Rank: 2, File: 2},
+				Destination: Position{Rank: 2, File: 1},
+				MoveType:    NORMAL,
+			},
+			expectedActive:     RED,
+			expectedEliminated: []Color{YELLOW, BLUE},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateLastPlayerStanding,
+				Winner:       RED,
+				Loser:        BLUE,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			turnState := &TurnState{
+				Active:    RED,
+				TurnOrder: []Color{BLUE, YELLOW, RED},
+			}
+			board := Build(
+				WithCastlingState(NewCastlingState()),
+				WithEliminationState(NewDefaultEliminationState()),
+				WithIllegalStateFilter(
+					NewIllegalStateFilter(
+						&IllegalCheckStateFilter{TurnState: turnState},
+					),
+				),
+				WithInsufficientMaterialRule(nil),
+				WithTurnState(turnState),
+				WithGameboardState(tc.state),
+			)
+
+			assert.Nil(t, board.HandleMove(tc.move))
+			assert.Equal(t, tc.expectedActive, board.GetActivePlayer())
+			assert.Equal(t, tc.expectedEliminated, board.GetEliminated())
+			assert.Equal(t, tc.expectedGameEndState, board.GetGameEndState())
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package board
+
+// EnPassantState is used to represent the square a pawn
+// may be captured on en passant after a PAWN_DOUBLE_PUSH.
+type EnPassantState struct {
+	// Target is the square passed over by the double pushed pawn.
+	Target *Position
+	// Captured is the square the double pushed pawn landed on.
+	Captured *Position
+}
+
+// NewDefaultEnPassantState creates a new EnPassantState with no target.
+func NewDefaultEnPassantState() *EnPassantState {
+	return &EnPassantState{}
+}
+
+// IsEnPassantTarget returns true if the provided Position is the en passant target.
+func (e *EnPassantState) IsEnPassantTarget(position Position) bool {
+	return e.Target != nil && *e.Target == position
+}
+
+// UpdateEnPassantState records the target square after a PAWN_DOUBLE_PUSH,
+// any other move clears it.
+func (e *EnPassantState) UpdateEnPassantState(move Move) {
+	if move.MoveType != PAWN_DOUBLE_PUSH {
+		e.Target = nil
+		e.Captured = nil
+		return
+	}
+
+	target := Position{
+		Rank: (move.Source.Rank + move.Destination.Rank) / 2,
+		File: (move.Source.File + move.Destination.File) / 2,
+	}
+	captured := move.Destination
+	e.Target = &target
+	e.Captured = &captured
+}
@@ -0,0 +1,70 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateEnPassantState(t *testing.T) {
+	testcases := []struct {
+		name                   string
+		moves                  []Move
+		expectedEnPassantState *EnPassantState
+	}{
+		{
+			name: "White pawn double push.",
+			moves: []Move{
+				{
+					Source:      Position{Rank: 1, File: 4},
+					Destination: Position{Rank: 3, File: 4},
+					MoveType:    PAWN_DOUBLE_PUSH,
+				},
+			},
+			expectedEnPassantState: &EnPassantState{
+				Target:   &Position{Rank: 2, File: 4},
+				Captured: &Position{Rank: 3, File: 4},
+			},
+		},
+		{
+			name: "Black pawn double push.",
+			moves: []Move{
+				{
+					Source:      Position{Rank: 6, File: 3},
+					Destination: Position{Rank: 4, File: 3},
+					MoveType:    PAWN_DOUBLE_PUSH,
+				},
+			},
+			expectedEnPassantState: &EnPassantState{
+				Target:   &Position{Rank: 5, File: 3},
+				Captured: &Position{Rank: 4, File: 3},
+			},
+		},
+		{
+			name: "Target cleared by the following move.",
+			moves: []Move{
+				{
+					Source:      Position{Rank: 1, File: 4},
+					Destination: Position{Rank: 3, File: 4},
+					MoveType:    PAWN_DOUBLE_PUSH,
+				},
+				{
+					Source:      Position{Rank: 6, File: 0},
+					Destination: Position{Rank: 5, File: 0},
+					MoveType:    NORMAL,
+				},
+			},
+			expectedEnPassantState: &EnPassantState{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			enPassantState := NewDefaultEnPassantState()
+			for _, move := range tc.moves {
+				enPassantState.UpdateEnPassantState(move)
+			}
+			assert.Equal(t, tc.expectedEnPassantState, enPassantState)
+		})
+	}
+}
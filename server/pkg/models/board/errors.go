@@ -12,16 +12,62 @@ var errCannotHandleMoveType = func(m MoveType) error {
 
 var errSourcePieceNotFound = errortypes.New(errortypes.BadRequest, "Move error: source piece not found.")
 
+var errCapturedPieceNotFound = errortypes.New(errortypes.BadRequest, "Move error: captured piece not found.")
+
+var errDropSquareOccupied = errortypes.New(errortypes.BadRequest, "Move error: drop square is occupied.")
+
+var errCastlingRuleNotFound = errortypes.New(errortypes.BadRequest, "Move error: castling rule not found.")
+
 var errInvalidColor = func(color Color) error {
 	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Move error: color is invalid %s", color.String()))
 }
 
+var errInvalidPieceType = func(pieceType PieceType) error {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Move error: piece type is invalid %s", pieceType.String()))
+}
+
+var errInvalidPieceTypeName = func(name string) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Piece error: piece type is invalid %q", name))
+}
+
+var errInvalidBetza = func(notation, reason string) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Piece error: invalid Betza notation %q: %s", notation, reason))
+}
+
+var errInvalidPieceRegistration = func(name, reason string) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Piece error: unable to register piece type %q: %s", name, reason))
+}
+
 var errPieceNotFound = errortypes.New(errortypes.NotFound, "Board error: piece not found")
 
 var errApplyingMove = errortypes.New(errortypes.BadRequest, "Board error: unable to apply move")
 
 var errMoveNotAllowed = errortypes.New(errortypes.BadRequest, "Board error: move is not allowed")
 
+var errNotActivePlayer = func(color Color) error {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Board error: player %s is not the active player", color.String()))
+}
+
+var errGameEnded = errortypes.New(errortypes.BadRequest, "Board error: game has ended")
+
+var errDrawNotClaimable = errortypes.New(errortypes.BadRequest, "Board error: draw cannot be claimed")
+
 var errNotAllowedToCastle = func(color Color) errortypes.TypedError {
 	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Board error: player %s is not allowed to castle", color.String()))
 }
+
+var errInvalidSquare = func(square string) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Notation error: invalid square %s", square))
+}
+
+var errInvalidFEN = func(fen, reason string) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("FEN error: invalid FEN %q: %s", fen, reason))
+}
+
+var errInvalidSAN = func(san, reason string) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Notation error: invalid move %q: %s", san, reason))
+}
+
+var errInvalidPGN = func(reason string) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("PGN error: invalid PGN: %s", reason))
+}
@@ -0,0 +1,374 @@
+package board
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// FENPosition represents a position parsed from a FEN string.
+type FENPosition struct {
+	GameboardState   GameboardState
+	Active           Color
+	CastlingStateMap map[MoveType]map[Color]bool
+	EnPassantState   EnPassantState
+	MoveCounter      MoveCounter
+	// CheckCounts maps each Color to the number of checks it has given, nil if not provided.
+	CheckCounts map[Color]int
+	// Reserves maps each Color to the pieces in its reserve, nil if not provided.
+	Reserves map[Color]map[PieceType]int
+}
+
+// fenCastlingSymbols maps FEN castling symbols to the castle they allow.
+var fenCastlingSymbols = []struct {
+	symbol   rune
+	moveType MoveType
+	color    Color
+}{
+	{'K', KINGSIDE_CASTLE, WHITE},
+	{'Q', QUEENSIDE_CASTLE, WHITE},
+	{'k', KINGSIDE_CASTLE, BLACK},
+	{'q', QUEENSIDE_CASTLE, BLACK},
+}
+
+// fenVoidSymbol is the FEN symbol of a square masked off the board.
+const fenVoidSymbol = '*'
+
+// fenSymbol returns the FEN symbol of the Piece, uppercase for white and lowercase for black.
+func fenSymbol(piece *Piece) rune {
+	if piece.Color == WHITE {
+		return pieceTypeLetter(piece.PieceType)
+	}
+	return unicode.ToLower(pieceTypeLetter(piece.PieceType))
+}
+
+// ParseFEN parses a FEN string into a FENPosition for a Board with the provided Bounds.
+// The piece placement can be followed by the reserves of crazyhouse in brackets, e.g. [Qp],
+// with promoted pieces marked by a tilde, e.g. Q~.
+// Squares masked off the Bounds are written as an asterisk, empty square counts may span them.
+// The halfmove and fullmove counters are optional,
+// they can be followed by the check counts of three-check, e.g. +1+0.
+func ParseFEN(fen string, bounds Bounds) (*FENPosition, error) {
+	fields := strings.Fields(fen)
+	if len(fields) != 4 && len(fields) != 6 && len(fields) != 7 {
+		return nil, errInvalidFEN(fen, "expected 4, 6 or 7 fields")
+	}
+
+	position := &FENPosition{
+		CastlingStateMap: map[MoveType]map[Color]bool{
+			KINGSIDE_CASTLE:  {WHITE: false, BLACK: false},
+			QUEENSIDE_CASTLE: {WHITE: false, BLACK: false},
+		},
+		MoveCounter: NewDefaultMoveCounter(),
+	}
+
+	// Parse the reserves.
+	placement := fields[0]
+	if start := strings.Index(placement, "["); start != -1 {
+		if !strings.HasSuffix(placement, "]") {
+			return nil, errInvalidFEN(fen, "invalid reserves")
+		}
+		reserves, err := parseFENReserves(placement[start+1 : len(placement)-1])
+		if err != nil {
+			return nil, errInvalidFEN(fen, err.Error())
+		}
+		position.Reserves = reserves
+		placement = placement[:start]
+	}
+
+	// Parse the piece placement.
+	state, err := parseFENPlacement(placement, bounds)
+	if err != nil {
+		return nil, errInvalidFEN(fen, err.Error())
+	}
+	position.GameboardState = state
+
+	// Parse the active color.
+	switch fields[1] {
+	case "w":
+		position.Active = WHITE
+	case "b":
+		position.Active = BLACK
+	default:
+		return nil, errInvalidFEN(fen, "invalid active color")
+	}
+
+	// Parse the castling rights.
+	if fields[2] != "-" {
+		for _, symbol := range fields[2] {
+			found := false
+			for _, castle := range fenCastlingSymbols {
+				if castle.symbol == symbol {
+					position.CastlingStateMap[castle.moveType][castle.color] = true
+					found = true
+				}
+			}
+			if !found {
+				return nil, errInvalidFEN(fen, "invalid castling rights")
+			}
+		}
+	}
+
+	// Parse the en passant target, the captured pawn is one step past it
+	// in the direction the inactive color moves.
+	if fields[3] != "-" {
+		target, err := SquareToPosition(fields[3])
+		if err != nil || !bounds.IsInboundsPosition(target) {
+			return nil, errInvalidFEN(fen, "invalid en passant square")
+		}
+		captured := target
+		if position.Active == BLACK {
+			captured.Rank += 1
+		} else {
+			captured.Rank -= 1
+		}
+		position.EnPassantState = EnPassantState{
+			Target:   &target,
+			Captured: &captured,
+		}
+	}
+
+	// Parse the move counters.
+	if len(fields) >= 6 {
+		halfmoveClock, err := strconv.Atoi(fields[4])
+		if err != nil || halfmoveClock < 0 {
+			return nil, errInvalidFEN(fen, "invalid halfmove clock")
+		}
+		fullmoveNumber, err := strconv.Atoi(fields[5])
+		if err != nil || fullmoveNumber < 1 {
+			return nil, errInvalidFEN(fen, "invalid fullmove number")
+		}
+		position.MoveCounter = MoveCounter{
+			HalfmoveClock:  halfmoveClock,
+			FullmoveNumber: fullmoveNumber,
+		}
+	}
+
+	// Parse the check counts.
+	if len(fields) == 7 {
+		checkCounts, err := parseFENCheckCounts(fields[6])
+		if err != nil {
+			return nil, errInvalidFEN(fen, err.Error())
+		}
+		position.CheckCounts = checkCounts
+	}
+
+	return position, nil
+}
+
+// parseFENPlacement parses the piece placement field of a FEN string,
+// ranks are listed from the last rank to the first.
+func parseFENPlacement(placement string, bounds Bounds) (GameboardState, error) {
+	ranks := strings.Split(placement, "/")
+	if len(ranks) != bounds.RankCount {
+		return nil, fmt.Errorf("expected %d ranks", bounds.RankCount)
+	}
+
+	state := NewGameboardState(bounds, GameboardState{})
+	for i, rankPlacement := range ranks {
+		rank := bounds.RankCount - 1 - i
+		file := 0
+		emptyCount := 0
+		var lastPiece *Piece
+		for _, symbol := range rankPlacement {
+			if symbol == '~' {
+				if lastPiece == nil || emptyCount > 0 {
+					return nil, fmt.Errorf("promoted marker without a piece")
+				}
+				lastPiece.Promoted = true
+				continue
+			}
+			lastPiece = nil
+			if unicode.IsDigit(symbol) {
+				emptyCount = emptyCount*10 + int(symbol-'0')
+				continue
+			}
+			file += emptyCount
+			emptyCount = 0
+
+			if symbol == fenVoidSymbol {
+				if !bounds.IsVoid(Position{Rank: rank, File: file}) {
+					return nil, fmt.Errorf("square %s isn't masked off", PositionToSquare(Position{Rank: rank, File: file}))
+				}
+				file += 1
+				continue
+			}
+
+			pieceType, ok := letterPieceType(symbol)
+			if !ok {
+				return nil, fmt.Errorf("invalid piece %c", symbol)
+			}
+			color := Color(BLACK)
+			if unicode.IsUpper(symbol) {
+				color = WHITE
+			}
+
+			if file >= bounds.FileCount {
+				return nil, fmt.Errorf("rank %d has too many files", rank+1)
+			}
+			if bounds.IsVoid(Position{Rank: rank, File: file}) {
+				return nil, fmt.Errorf("piece on masked off square %s", PositionToSquare(Position{Rank: rank, File: file}))
+			}
+			piece, err := NewPieceOfType(color, pieceType, bounds)
+			if err != nil {
+				return nil, err
+			}
+			state[rank][file] = piece
+			lastPiece = piece
+			file += 1
+		}
+		file += emptyCount
+
+		if file != bounds.FileCount {
+			return nil, fmt.Errorf("rank %d expected %d files", rank+1, bounds.FileCount)
+		}
+	}
+
+	return state, nil
+}
+
+// WithFEN sets the Board's position from a FENPosition.
+// It should be provided after any other options the FENPosition overrides.
+func WithFEN(position *FENPosition) BuilderOption {
+	return func(c *Builder) {
+		c.gameboardState = NewGameboardState(c.bounds, position.GameboardState)
+
+		// Pass the turn until the FEN's active color is to move.
+		for i := 0; i < len(c.turnState.TurnOrder) && c.turnState.Active != position.Active; i++ {
+			c.turnState.PassTurn()
+		}
+
+		if c.castlingState != nil {
+			for moveType, colorMap := range position.CastlingStateMap {
+				if _, ok := c.castlingState.CastlingStateMap[moveType]; !ok {
+					c.castlingState.CastlingStateMap[moveType] = map[Color]bool{}
+				}
+				for color, allowed := range colorMap {
+					c.castlingState.CastlingStateMap[moveType][color] = allowed
+				}
+			}
+		}
+
+		if c.enPassantState != nil {
+			c.enPassantState.Target = position.EnPassantState.Target
+			c.enPassantState.Captured = position.EnPassantState.Captured
+		}
+
+		if c.checkCountState != nil && position.CheckCounts != nil {
+			for color, checks := range position.CheckCounts {
+				c.checkCountState.Checks[color] = checks
+			}
+		}
+
+		if c.reserveState != nil && position.Reserves != nil {
+			for color, reserve := range position.Reserves {
+				c.reserveState.Reserves[color] = map[PieceType]int{}
+				for pieceType, count := range reserve {
+					c.reserveState.Reserves[color][pieceType] = count
+				}
+			}
+		}
+
+		c.moveCounter = position.MoveCounter
+	}
+}
+
+// FEN returns the FEN string of the Board, with the reserves after the piece placement
+// if the Board keeps reserves and followed by the check counts if the Board counts checks.
+func (b *Board) FEN() string {
+	placement := b.fenPlacement()
+	if b.ReserveState != nil {
+		placement += b.fenReserves()
+	}
+	fields := []string{
+		placement,
+		b.fenActiveColor(),
+		b.fenCastlingRights(),
+		b.fenEnPassantTarget(),
+		strconv.Itoa(b.HalfmoveClock),
+		strconv.Itoa(b.FullmoveNumber),
+	}
+	if b.CheckCountState != nil {
+		fields = append(fields, b.fenCheckCounts())
+	}
+	return strings.Join(fields, " ")
+}
+
+// IsFENSupported returns true if FEN can describe the Board, it only encodes white and black pieces.
+func (b *Board) IsFENSupported() bool {
+	for _, color := range b.GetColors() {
+		if color != WHITE && color != BLACK {
+			return false
+		}
+	}
+	return true
+}
+
+// fenPlacement returns the piece placement field of the Board's FEN.
+func (b *Board) fenPlacement() string {
+	ranks := make([]string, 0, b.RankCount)
+	for rank := b.RankCount - 1; rank >= 0; rank-- {
+		builder := strings.Builder{}
+		emptyCount := 0
+		for file := 0; file < b.FileCount; file++ {
+			piece := b.GameboardState[rank][file]
+			void := b.IsVoid(Position{Rank: rank, File: file})
+			if piece == nil && !void {
+				emptyCount += 1
+				continue
+			}
+			if emptyCount > 0 {
+				builder.WriteString(strconv.Itoa(emptyCount))
+				emptyCount = 0
+			}
+			if void {
+				builder.WriteRune(fenVoidSymbol)
+				continue
+			}
+			builder.WriteRune(fenSymbol(piece))
+			if piece.Promoted {
+				builder.WriteString("~")
+			}
+		}
+		if emptyCount > 0 {
+			builder.WriteString(strconv.Itoa(emptyCount))
+		}
+		ranks = append(ranks, builder.String())
+	}
+	return strings.Join(ranks, "/")
+}
+
+// fenActiveColor returns the active color field of the Board's FEN.
+func (b *Board) fenActiveColor() string {
+	if b.GetActivePlayer() == BLACK {
+		return "b"
+	}
+	return "w"
+}
+
+// fenCastlingRights returns the castling rights field of the Board's FEN.
+func (b *Board) fenCastlingRights() string {
+	if b.CastlingState == nil {
+		return "-"
+	}
+
+	rights := ""
+	for _, castle := range fenCastlingSymbols {
+		if b.IsAllowed(castle.moveType, castle.color) {
+			rights += string(castle.symbol)
+		}
+	}
+	if rights == "" {
+		return "-"
+	}
+	return rights
+}
+
+// fenEnPassantTarget returns the en passant field of the Board's FEN.
+func (b *Board) fenEnPassantTarget() string {
+	if b.EnPassantState == nil || b.EnPassantState.Target == nil {
+		return "-"
+	}
+	return PositionToSquare(*b.EnPassantState.Target)
+}
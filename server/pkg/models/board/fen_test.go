@@ -0,0 +1,414 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const startingFEN = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
+
+func TestParseFEN(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	target := Position{Rank: 2, File: 4}
+	captured := Position{Rank: 3, File: 4}
+	testCases := []struct {
+		name             string
+		fen              string
+		bounds           Bounds
+		expectedPosition *FENPosition
+	}{
+		{
+			name:   "Kings only, black to move after a double push.",
+			fen:    "4k3/8/8/8/4P3/8/8/4K3 b Kq e3 0 1",
+			bounds: bounds,
+			expectedPosition: &FENPosition{
+				GameboardState: NewGameboardState(
+					bounds,
+					GameboardState{
+						0: {4: NewKing(WHITE)},
+						3: {4: NewPawn(WHITE)},
+						7: {4: NewKing(BLACK)},
+					},
+				),
+				Active: BLACK,
+				CastlingStateMap: map[MoveType]map[Color]bool{
+					KINGSIDE_CASTLE:  {WHITE: true, BLACK: false},
+					QUEENSIDE_CASTLE: {WHITE: false, BLACK: true},
+				},
+				EnPassantState: EnPassantState{
+					Target:   &target,
+					Captured: &captured,
+				},
+				MoveCounter: MoveCounter{HalfmoveClock: 0, FullmoveNumber: 1},
+			},
+		},
+		{
+			name:   "Move counters are optional.",
+			fen:    "4k3/8/8/8/8/8/8/4K3 w - -",
+			bounds: bounds,
+			expectedPosition: &FENPosition{
+				GameboardState: NewGameboardState(
+					bounds,
+					GameboardState{
+						0: {4: NewKing(WHITE)},
+						7: {4: NewKing(BLACK)},
+					},
+				),
+				Active: WHITE,
+				CastlingStateMap: map[MoveType]map[Color]bool{
+					KINGSIDE_CASTLE:  {WHITE: false, BLACK: false},
+					QUEENSIDE_CASTLE: {WHITE: false, BLACK: false},
+				},
+				MoveCounter: NewDefaultMoveCounter(),
+			},
+		},
+		{
+			name:   "Multi-digit empty squares on a wide board.",
+			fen:    "k9/10/10/10/10/10/10/9K w - - 12 40",
+			bounds: Bounds{RankCount: 8, FileCount: 10},
+			expectedPosition: &FENPosition{
+				GameboardState: NewGameboardState(
+					Bounds{RankCount: 8, FileCount: 10},
+					GameboardState{
+						0: {9: NewKing(WHITE)},
+						7: {0: NewKing(BLACK)},
+					},
+				),
+				Active: WHITE,
+				CastlingStateMap: map[MoveType]map[Color]bool{
+					KINGSIDE_CASTLE:  {WHITE: false, BLACK: false},
+					QUEENSIDE_CASTLE: {WHITE: false, BLACK: false},
+				},
+				MoveCounter: MoveCounter{HalfmoveClock: 12, FullmoveNumber: 40},
+			},
+		},
+		{
+			name:   "Three-check counts.",
+			fen:    "4k3/8/8/8/8/8/8/4K3 w - - 0 5 +2+1",
+			bounds: bounds,
+			expectedPosition: &FENPosition{
+				GameboardState: NewGameboardState(
+					bounds,
+					GameboardState{
+						0: {4: NewKing(WHITE)},
+						7: {4: NewKing(BLACK)},
+					},
+				),
+				Active: WHITE,
+				CastlingStateMap: map[MoveType]map[Color]bool{
+					KINGSIDE_CASTLE:  {WHITE: false, BLACK: false},
+					QUEENSIDE_CASTLE: {WHITE: false, BLACK: false},
+				},
+				MoveCounter: MoveCounter{HalfmoveClock: 0, FullmoveNumber: 5},
+				CheckCounts: map[Color]int{WHITE: 2, BLACK: 1},
+			},
+		},
+		{
+			name:   "Crazyhouse reserves and promoted pieces.",
+			fen:    "Q~3k3/8/8/8/8/8/8/4K3[NNp] b - - 0 1",
+			bounds: bounds,
+			expectedPosition: &FENPosition{
+				GameboardState: NewGameboardState(
+					bounds,
+					GameboardState{
+						0: {4: NewKing(WHITE)},
+						7: {
+							0: func() *Piece {
+								queen := NewQueen(WHITE, bounds)
+								queen.Promoted = true
+								return queen
+							}(),
+							4: NewKing(BLACK),
+						},
+					},
+				),
+				Active: BLACK,
+				CastlingStateMap: map[MoveType]map[Color]bool{
+					KINGSIDE_CASTLE:  {WHITE: false, BLACK: false},
+					QUEENSIDE_CASTLE: {WHITE: false, BLACK: false},
+				},
+				MoveCounter: NewDefaultMoveCounter(),
+				Reserves: map[Color]map[PieceType]int{
+					WHITE: {KNIGHT: 2},
+					BLACK: {PAWN: 1},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, tc.bounds)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedPosition, position)
+		})
+	}
+}
+
+func TestParseFENInvalid(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	testCases := []struct {
+		name string
+		fen  string
+	}{
+		{
+			name: "Missing fields.",
+			fen:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w",
+		},
+		{
+			name: "Too few ranks.",
+			fen:  "rnbqkbnr/pppppppp/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+		},
+		{
+			name: "Too many files.",
+			fen:  "rnbqkbnr/pppppppp/9/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+		},
+		{
+			name: "Invalid piece.",
+			fen:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNX w KQkq - 0 1",
+		},
+		{
+			name: "Invalid active color.",
+			fen:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR x KQkq - 0 1",
+		},
+		{
+			name: "Invalid castling rights.",
+			fen:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KXkq - 0 1",
+		},
+		{
+			name: "Invalid en passant square.",
+			fen:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq z9 0 1",
+		},
+		{
+			name: "Invalid fullmove number.",
+			fen:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 0",
+		},
+		{
+			name: "Invalid check counts.",
+			fen:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1 +1",
+		},
+		{
+			name: "Negative check count.",
+			fen:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1 +1+-1",
+		},
+		{
+			name: "Unclosed reserves.",
+			fen:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[Qp w KQkq - 0 1",
+		},
+		{
+			name: "Invalid reserve piece.",
+			fen:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[X] w KQkq - 0 1",
+		},
+		{
+			name: "Promoted marker without a piece.",
+			fen:  "~nbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, bounds)
+			assert.Nil(t, position)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestFEN(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	testCases := []struct {
+		name        string
+		fen         string
+		moves       []Move
+		expectedFEN string
+	}{
+		{
+			name:        "Starting position round trip.",
+			fen:         startingFEN,
+			expectedFEN: startingFEN,
+		},
+		{
+			name: "Double push sets the en passant target.",
+			fen:  startingFEN,
+			moves: []Move{
+				{
+					Source:      Position{Rank: 1, File: 4},
+					Destination: Position{Rank: 3, File: 4},
+					MoveType:    PAWN_DOUBLE_PUSH,
+				},
+			},
+			expectedFEN: "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1",
+		},
+		{
+			name: "Knight moves advance the counters.",
+			fen:  startingFEN,
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 6},
+					Destination: Position{Rank: 2, File: 5},
+					MoveType:    JUMP,
+				},
+				{
+					Source:      Position{Rank: 7, File: 6},
+					Destination: Position{Rank: 5, File: 5},
+					MoveType:    JUMP,
+				},
+			},
+			expectedFEN: "rnbqkb1r/pppppppp/5n2/8/8/5N2/PPPPPPPP/RNBQKB1R w KQkq - 2 2",
+		},
+		{
+			name: "King move loses castling rights.",
+			fen:  "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 5 10",
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 4},
+					Destination: Position{Rank: 1, File: 4},
+					MoveType:    NORMAL,
+				},
+			},
+			expectedFEN: "r3k2r/8/8/8/8/8/4K3/R6R b kq - 6 10",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, bounds)
+			assert.Nil(t, err)
+
+			board := Build(WithFEN(position))
+			for _, move := range tc.moves {
+				assert.Nil(t, board.HandleMove(move))
+			}
+			assert.Equal(t, tc.expectedFEN, board.FEN())
+		})
+	}
+}
+
+func TestFENVoids(t *testing.T) {
+	bounds := Bounds{
+		RankCount: 4,
+		FileCount: 4,
+		Voids:     []Position{{Rank: 1, File: 1}, {Rank: 2, File: 1}, {Rank: 2, File: 2}},
+	}
+	testCases := []struct {
+		name        string
+		fen         string
+		expectErr   bool
+		expectedFEN string
+	}{
+		{
+			name:        "Voids round trip.",
+			fen:         "k3/p**1/1*1P/3K w - - 0 1",
+			expectedFEN: "k3/p**1/1*1P/3K w - - 0 1",
+		},
+		{
+			name:        "Empty square counts span voids.",
+			fen:         "k3/4/4/3K w - - 0 1",
+			expectedFEN: "k3/1**1/1*2/3K w - - 0 1",
+		},
+		{
+			name:      "Void marker on a square of the board.",
+			fen:       "k3/4/4/*2K w - - 0 1",
+			expectErr: true,
+		},
+		{
+			name:      "Piece on a void.",
+			fen:       "k3/4/1P2/3K w - - 0 1",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, bounds)
+			if tc.expectErr {
+				assert.Nil(t, position)
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+
+			board := Build(
+				WithBounds(bounds),
+				WithMoveFilter(NewMoveFilter(
+					&FilterOutOfBounds{Bounds: bounds},
+					&FilterPieceCollision{Bounds: bounds},
+					&FilterFriendlyCapture{},
+				)),
+				WithFEN(position),
+			)
+			assert.Equal(t, tc.expectedFEN, board.FEN())
+		})
+	}
+}
+
+func TestUpdateMoveCounter(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	testCases := []struct {
+		name            string
+		moveCounter     MoveCounter
+		piece           *Piece
+		isCapture       bool
+		expectedCounter MoveCounter
+	}{
+		{
+			name:            "White quiet move.",
+			moveCounter:     MoveCounter{HalfmoveClock: 3, FullmoveNumber: 5},
+			piece:           NewKnight(WHITE),
+			expectedCounter: MoveCounter{HalfmoveClock: 4, FullmoveNumber: 5},
+		},
+		{
+			name:            "Black quiet move.",
+			moveCounter:     MoveCounter{HalfmoveClock: 3, FullmoveNumber: 5},
+			piece:           NewKnight(BLACK),
+			expectedCounter: MoveCounter{HalfmoveClock: 4, FullmoveNumber: 5},
+		},
+		{
+			name:            "Pawn move resets the halfmove clock.",
+			moveCounter:     MoveCounter{HalfmoveClock: 3, FullmoveNumber: 5},
+			piece:           NewPawn(WHITE),
+			expectedCounter: MoveCounter{HalfmoveClock: 0, FullmoveNumber: 5},
+		},
+		{
+			name:            "Capture resets the halfmove clock.",
+			moveCounter:     MoveCounter{HalfmoveClock: 3, FullmoveNumber: 5},
+			piece:           NewRook(BLACK, bounds),
+			isCapture:       true,
+			expectedCounter: MoveCounter{HalfmoveClock: 0, FullmoveNumber: 5},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.moveCounter.UpdateMoveCounter(tc.piece, tc.isCapture)
+			assert.Equal(t, tc.expectedCounter, tc.moveCounter)
+		})
+	}
+}
+
+func TestIsFENSupported(t *testing.T) {
+	testCases := []struct {
+		name              string
+		turnState         *TurnState
+		expectedSupported bool
+	}{
+		{
+			name:              "White and black.",
+			turnState:         &TurnState{Active: WHITE, TurnOrder: []Color{BLACK, WHITE}},
+			expectedSupported: true,
+		},
+		{
+			name:              "Four players.",
+			turnState:         &TurnState{Active: RED, TurnOrder: []Color{BLUE, YELLOW, GREEN, RED}},
+			expectedSupported: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			board := Build(WithTurnState(tc.turnState))
+			assert.Equal(t, tc.expectedSupported, board.IsFENSupported())
+		})
+	}
+}
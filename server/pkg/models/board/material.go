@@ -0,0 +1,68 @@
+package board
+
+type insufficientMaterialRule interface {
+	IsInsufficientMaterial(state GameboardState) bool
+}
+
+// InsufficientMaterialRule bundles multiple insufficientMaterialRule into a single struct.
+type InsufficientMaterialRule struct {
+	insufficientMaterialRules []insufficientMaterialRule
+}
+
+// IsInsufficientMaterial returns true if any of the rules finds that neither side can mate.
+func (i *InsufficientMaterialRule) IsInsufficientMaterial(state GameboardState) bool {
+	for _, rule := range i.insufficientMaterialRules {
+		if rule.IsInsufficientMaterial(state) {
+			return true
+		}
+	}
+	return false
+}
+
+func NewInsufficientMaterialRule(insufficientMaterialRules ...insufficientMaterialRule) *InsufficientMaterialRule {
+	return &InsufficientMaterialRule{
+		insufficientMaterialRules: insufficientMaterialRules,
+	}
+}
+
+// ClassicInsufficientMaterialRule detects the classic positions where neither side can mate:
+// K vs K, K+B vs K, K+N vs K and kings with bishops that are all on the same square color.
+type ClassicInsufficientMaterialRule struct{}
+
+// IsInsufficientMaterial checks the pieces other than the kings on the provided state.
+func (r *ClassicInsufficientMaterialRule) IsInsufficientMaterial(state GameboardState) bool {
+	pieceTypes := []PieceType{}
+	bishopSquareColors := map[int]bool{}
+	for rank, files := range state {
+		for file, piece := range files {
+			if piece == nil || piece.PieceType == KING {
+				continue
+			}
+			pieceTypes = append(pieceTypes, piece.PieceType)
+			if piece.PieceType == BISHOP {
+				bishopSquareColors[(rank+file)%2] = true
+			}
+		}
+	}
+
+	switch {
+	case len(pieceTypes) == 0:
+		return true
+	case len(pieceTypes) == 1:
+		return pieceTypes[0] == BISHOP || pieceTypes[0] == KNIGHT
+	default:
+		// Any number of bishops can't mate if they are all on the same square color.
+		return len(bishopSquareColors) == 1 && len(pieceTypes) == countPieceType(pieceTypes, BISHOP)
+	}
+}
+
+// countPieceType returns the number of the provided PieceType in the list.
+func countPieceType(pieceTypes []PieceType, pieceType PieceType) int {
+	count := 0
+	for _, p := range pieceTypes {
+		if p == pieceType {
+			count += 1
+		}
+	}
+	return count
+}
@@ -0,0 +1,79 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassicInsufficientMaterialRule(t *testing.T) {
+	testcases := []struct {
+		name                           string
+		fen                            string
+		expectedIsInsufficientMaterial bool
+	}{
+		{
+			name:                           "King vs king.",
+			fen:                            "4k3/8/8/8/8/8/8/4K3 w - - 0 1",
+			expectedIsInsufficientMaterial: true,
+		},
+		{
+			name:                           "King and bishop vs king.",
+			fen:                            "4k3/8/8/8/8/8/8/2B1K3 w - - 0 1",
+			expectedIsInsufficientMaterial: true,
+		},
+		{
+			name:                           "King and knight vs king.",
+			fen:                            "4k3/8/8/8/8/8/8/1N2K3 b - - 0 1",
+			expectedIsInsufficientMaterial: true,
+		},
+		{
+			name:                           "King and bishop vs king and bishop on the same square color.",
+			fen:                            "2b1k3/8/8/8/8/8/8/3BK3 w - - 0 1",
+			expectedIsInsufficientMaterial: true,
+		},
+		{
+			name:                           "King and bishop vs king and bishop on different square colors.",
+			fen:                            "2b1k3/8/8/8/8/8/8/2B1K3 w - - 0 1",
+			expectedIsInsufficientMaterial: false,
+		},
+		{
+			name:                           "King and two knights vs king.",
+			fen:                            "4k3/8/8/8/8/8/8/1N2K1N1 w - - 0 1",
+			expectedIsInsufficientMaterial: false,
+		},
+		{
+			name:                           "King and bishop vs king and knight.",
+			fen:                            "1n2k3/8/8/8/8/8/8/2B1K3 w - - 0 1",
+			expectedIsInsufficientMaterial: false,
+		},
+		{
+			name:                           "King and pawn vs king.",
+			fen:                            "4k3/8/8/8/8/8/4P3/4K3 w - - 0 1",
+			expectedIsInsufficientMaterial: false,
+		},
+		{
+			name:                           "King and rook vs king.",
+			fen:                            "4k3/8/8/8/8/8/8/R3K3 w - - 0 1",
+			expectedIsInsufficientMaterial: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, Bounds{RankCount: 8, FileCount: 8})
+			assert.Nil(t, err)
+
+			rule := &ClassicInsufficientMaterialRule{}
+			assert.Equal(t, tc.expectedIsInsufficientMaterial, rule.IsInsufficientMaterial(position.GameboardState))
+		})
+	}
+}
+
+func TestInsufficientMaterialRule(t *testing.T) {
+	position, err := ParseFEN("4k3/8/8/8/8/8/8/4K3 w - - 0 1", Bounds{RankCount: 8, FileCount: 8})
+	assert.Nil(t, err)
+
+	assert.True(t, NewInsufficientMaterialRule(&ClassicInsufficientMaterialRule{}).IsInsufficientMaterial(position.GameboardState))
+	assert.False(t, NewInsufficientMaterialRule().IsInsufficientMaterial(position.GameboardState))
+}
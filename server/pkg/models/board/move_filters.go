@@ -33,29 +33,155 @@ func (f *FilterOutOfBounds) IsLegalMove(move Move, state GameboardState) bool {
 	return f.IsInboundsPosition(move.Source) && f.IsInboundsPosition(move.Destination)
 }
 
-// FilterPieceCollision disallows pieces to move through other pieces.
-type FilterPieceCollision struct{}
+// FilterPieceCollision disallows pieces to move through other pieces or the squares masked off the Bounds.
+type FilterPieceCollision struct {
+	Bounds
+}
 
 func (f *FilterPieceCollision) IsLegalMove(move Move, state GameboardState) bool {
 	switch move.MoveType {
 	case NORMAL, CAPTURE, PAWN_DOUBLE_PUSH:
 		return f.isEmptyRay(move.MoveType, move.Source, move.Destination, state)
+	case JUMP:
+		return state[move.Destination.Rank][move.Destination.File] == nil
+	case RIDE, RIDE_CAPTURE:
+		return f.isEmptyRide(move.MoveType, move.Source, move.Destination, state)
+	case HOP, HOP_CAPTURE:
+		return f.isHop(move.MoveType, move.Source, move.Destination, state)
 	default:
 		return true
 	}
 }
 
 func (f *FilterPieceCollision) isEmptyRay(moveType MoveType, source, destination Position, state GameboardState) bool {
+	if f.Wraps() {
+		// Around the edges the destination can be reached from more than one direction.
+		for direction := North; direction < None; direction++ {
+			if isEmptyPath(moveType == CAPTURE, GenerateRay(source, direction, f.Bounds), destination, state) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// A destination off the source's lines can't be reached, e.g. a step wrapped by the Board's Topology.
+	rankDiff := destination.Rank - source.Rank
+	fileDiff := destination.File - source.File
+	if rankDiff != 0 && fileDiff != 0 && abs(rankDiff) != abs(fileDiff) {
+		return false
+	}
+
 	direction := GetDirection(source, destination)
 	next := source
 	for {
 		next = StepInDirection(next, direction)
 		if next == destination {
 			return state[next.Rank][next.File] == nil || moveType == CAPTURE
-		} else if state[next.Rank][next.File] != nil {
+		} else if state[next.Rank][next.File] != nil || f.IsVoid(next) {
+			return false
+		}
+	}
+}
+
+// isEmptyRide returns true if the squares leapt to between the source and destination are empty,
+// the leap is the shortest one repeated to reach the destination.
+func (f *FilterPieceCollision) isEmptyRide(moveType MoveType, source, destination Position, state GameboardState) bool {
+	if f.Wraps() {
+		return f.isEmptyWrappedRide(moveType, source, destination, state)
+	}
+
+	rankDiff := destination.Rank - source.Rank
+	fileDiff := destination.File - source.File
+	leapCount := gcd(abs(rankDiff), abs(fileDiff))
+	if leapCount == 0 {
+		return false
+	}
+
+	next := source
+	for i := 1; i < leapCount; i++ {
+		next = Position{Rank: next.Rank + rankDiff/leapCount, File: next.File + fileDiff/leapCount}
+		if state[next.Rank][next.File] != nil || f.IsVoid(next) {
+			return false
+		}
+	}
+	return state[destination.Rank][destination.File] == nil || moveType == RIDE_CAPTURE
+}
+
+// isEmptyWrappedRide returns true if the squares leapt to before the destination are empty
+// on one of the rides of the piece at the source, the rides wrap across the edges of the board.
+func (f *FilterPieceCollision) isEmptyWrappedRide(moveType MoveType, source, destination Position, state GameboardState) bool {
+	piece := state[source.Rank][source.File]
+	if piece == nil {
+		return false
+	}
+
+	for _, generator := range piece.moveGenerators {
+		rider, ok := generator.(*RiderMoveGenerator)
+		if !ok {
+			continue
+		}
+		for _, l := range leapsOf(rider.m, rider.n) {
+			if isEmptyPath(moveType == RIDE_CAPTURE, rider.ride(source, l), destination, state) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isEmptyPath returns true if the path reaches the destination without passing through another piece,
+// the destination must be empty unless the move captures.
+func isEmptyPath(capture bool, path []Position, destination Position, state GameboardState) bool {
+	for _, position := range path {
+		if position == destination {
+			return state[position.Rank][position.File] == nil || capture
+		} else if state[position.Rank][position.File] != nil {
+			return false
+		}
+	}
+	return false
+}
+
+// isHop returns true if the destination is the square right behind the first piece
+// between it and the source in a straight line.
+func (f *FilterPieceCollision) isHop(moveType MoveType, source, destination Position, state GameboardState) bool {
+	if f.Wraps() {
+		for direction := North; direction < None; direction++ {
+			if isHopOnRay(GenerateRay(source, direction, f.Bounds), destination, state) {
+				return state[destination.Rank][destination.File] == nil || moveType == HOP_CAPTURE
+			}
+		}
+		return false
+	}
+
+	rankDiff := destination.Rank - source.Rank
+	fileDiff := destination.File - source.File
+	if source == destination || (rankDiff != 0 && fileDiff != 0 && abs(rankDiff) != abs(fileDiff)) {
+		return false
+	}
+
+	direction := GetDirection(source, destination)
+	next := StepInDirection(source, direction)
+	for state[next.Rank][next.File] == nil {
+		if next == destination || f.IsVoid(next) {
 			return false
 		}
+		next = StepInDirection(next, direction)
+	}
+	if StepInDirection(next, direction) != destination {
+		return false
+	}
+	return state[destination.Rank][destination.File] == nil || moveType == HOP_CAPTURE
+}
+
+// isHopOnRay returns true if the destination is the square of the ray right behind its first piece.
+func isHopOnRay(ray []Position, destination Position, state GameboardState) bool {
+	for i, position := range ray {
+		if state[position.Rank][position.File] != nil {
+			return i+1 < len(ray) && ray[i+1] == destination
+		}
 	}
+	return false
 }
 
 // FilterFriendlyCapture disallows pieces to capture a piece with the same COLOR.
@@ -63,7 +189,7 @@ type FilterFriendlyCapture struct{}
 
 func (f *FilterFriendlyCapture) IsLegalMove(move Move, state GameboardState) bool {
 	switch move.MoveType {
-	case CAPTURE, JUMP_CAPTURE:
+	case CAPTURE, JUMP_CAPTURE, RIDE_CAPTURE, HOP_CAPTURE:
 		capturingPiece := state[move.Source.Rank][move.Source.File]
 		capturedPiece := state[move.Destination.Rank][move.Destination.File]
 		if capturingPiece == nil || capturedPiece == nil || capturingPiece.Color == capturedPiece.Color {
@@ -75,8 +201,28 @@ func (f *FilterFriendlyCapture) IsLegalMove(move Move, state GameboardState) boo
 	}
 }
 
-// FilterInvalidPawnDoublePush disallows pawns to double outside of their initial position.
-type FilterInvalidPawnDoublePush struct{}
+// DoublePushRanks maps each Color to the rank its pawns may double push from,
+// pawns of a Color without a rank never double push. Pawns moving east or west double push from a file.
+type DoublePushRanks map[Color]int
+
+// NewDefaultDoublePushRanks returns DoublePushRanks for the second rank of each player in the provided Bounds.
+func NewDefaultDoublePushRanks(bounds Bounds) DoublePushRanks {
+	return DoublePushRanks{
+		WHITE: 1,
+		BLACK: bounds.RankCount - 2,
+	}
+}
+
+// IsDoublePushRank returns true if the provided rank is the double push rank for the Color.
+func (d DoublePushRanks) IsDoublePushRank(color Color, rank int) bool {
+	doublePushRank, ok := d[color]
+	return ok && doublePushRank == rank
+}
+
+// FilterInvalidPawnDoublePush disallows pawns to double push outside of their DoublePushRanks.
+type FilterInvalidPawnDoublePush struct {
+	DoublePushRanks
+}
 
 func (f *FilterInvalidPawnDoublePush) IsLegalMove(move Move, state GameboardState) bool {
 	switch move.MoveType {
@@ -85,14 +231,43 @@ func (f *FilterInvalidPawnDoublePush) IsLegalMove(move Move, state GameboardStat
 		if piece == nil {
 			return false
 		}
-		switch piece.Color {
-		case WHITE:
-			return move.Source.Rank == 1
-		case BLACK:
-			return move.Source.Rank == 6
-		default:
+		rank, _ := pawnCoordinates(piece.Color, move.Source)
+		return f.IsDoublePushRank(piece.Color, rank)
+	default:
+		return true
+	}
+}
+
+// FilterIllegalEnPassant disallows en passant captures that don't
+// target the square passed over by an enemy pawn's double push.
+type FilterIllegalEnPassant struct {
+	*EnPassantState
+}
+
+func (f *FilterIllegalEnPassant) IsLegalMove(move Move, state GameboardState) bool {
+	switch move.MoveType {
+	case EN_PASSANT:
+		if !f.IsEnPassantTarget(move.Destination) || f.Captured == nil {
+			return false
+		}
+
+		piece := state[move.Source.Rank][move.Source.File]
+		if piece == nil || piece.PieceType != PAWN {
 			return false
 		}
+
+		if state[move.Destination.Rank][move.Destination.File] != nil {
+			return false
+		}
+
+		capturedPiece := state[f.Captured.Rank][f.Captured.File]
+		if capturedPiece == nil ||
+			capturedPiece.PieceType != PAWN ||
+			capturedPiece.Color == piece.Color {
+			return false
+		}
+
+		return true
 	default:
 		return true
 	}
@@ -106,24 +281,7 @@ type FilterIllegalKingsideCastle struct {
 func (f *FilterIllegalKingsideCastle) IsLegalMove(move Move, state GameboardState) bool {
 	switch move.MoveType {
 	case KINGSIDE_CASTLE:
-		piece := state[move.Source.Rank][move.Source.File]
-		if piece == nil {
-			return false
-		}
-		switch piece.Color {
-		case WHITE:
-			if !f.IsAllowed(KINGSIDE_CASTLE, WHITE) {
-				return false
-			}
-			return state[0][5] == nil && state[0][6] == nil
-		case BLACK:
-			if !f.IsAllowed(KINGSIDE_CASTLE, BLACK) {
-				return false
-			}
-			return state[7][5] == nil && state[7][6] == nil
-		default:
-			return false
-		}
+		return f.isLegalCastle(move, state)
 	default:
 		return true
 	}
@@ -137,32 +295,33 @@ type FilterIllegalQueensideCastle struct {
 func (f *FilterIllegalQueensideCastle) IsLegalMove(move Move, state GameboardState) bool {
 	switch move.MoveType {
 	case QUEENSIDE_CASTLE:
-		piece := state[move.Source.Rank][move.Source.File]
-		if piece == nil {
-			return false
-		}
-		switch piece.Color {
-		case WHITE:
-			if !f.IsAllowed(QUEENSIDE_CASTLE, WHITE) {
-				return false
-			}
-			return state[0][1] == nil && state[0][2] == nil && state[0][3] == nil
-		case BLACK:
-			if !f.IsAllowed(QUEENSIDE_CASTLE, BLACK) {
-				return false
-			}
-			return state[7][1] == nil && state[7][2] == nil && state[7][3] == nil
-		default:
-			return false
-		}
+		return f.isLegalCastle(move, state)
 	default:
 		return true
 	}
 }
 
+// PromotionRanks maps each Color to the rank its pawns are promoted on,
+// pawns moving east or west are promoted on a file.
+type PromotionRanks map[Color]int
+
+// NewDefaultPromotionRanks returns PromotionRanks for the far rank of each player in the provided Bounds.
+func NewDefaultPromotionRanks(bounds Bounds) PromotionRanks {
+	return PromotionRanks{
+		WHITE: bounds.RankCount - 1,
+		BLACK: 0,
+	}
+}
+
+// IsPromotionRank returns true if the provided rank is the promotion rank for the Color.
+func (p PromotionRanks) IsPromotionRank(color Color, rank int) bool {
+	promotionRank, ok := p[color]
+	return ok && promotionRank == rank
+}
+
 // FilterIllegalPromotion disallows illegal promotions.
 type FilterIllegalPromotion struct {
-	bounds Bounds
+	PromotionRanks
 }
 
 func (f *FilterIllegalPromotion) IsLegalMove(move Move, state GameboardState) bool {
@@ -176,18 +335,14 @@ func (f *FilterIllegalPromotion) IsLegalMove(move Move, state GameboardState) bo
 			return false
 		}
 
-		switch piece.Color {
-		case WHITE:
-			return move.Source.Rank == 6 &&
-				move.Destination.Rank == 7 &&
-				move.Source.File == move.Destination.File
-		case BLACK:
-			return move.Source.Rank == 1 &&
-				move.Destination.Rank == 0 &&
-				move.Source.File == move.Destination.File
-		default:
+		if state[move.Destination.Rank][move.Destination.File] != nil {
 			return false
 		}
+
+		_, sourceFile := pawnCoordinates(piece.Color, move.Source)
+		destinationRank, destinationFile := pawnCoordinates(piece.Color, move.Destination)
+		return sourceFile == destinationFile &&
+			f.IsPromotionRank(piece.Color, destinationRank)
 	default:
 		return true
 	}
@@ -195,7 +350,7 @@ func (f *FilterIllegalPromotion) IsLegalMove(move Move, state GameboardState) bo
 
 // FilterIllegalPromotionCapture disallows illegal promotion captures.
 type FilterIllegalPromotionCapture struct {
-	bounds Bounds
+	PromotionRanks
 }
 
 func (f *FilterIllegalPromotionCapture) IsLegalMove(move Move, state GameboardState) bool {
@@ -218,21 +373,96 @@ func (f *FilterIllegalPromotionCapture) IsLegalMove(move Move, state GameboardSt
 		}
 
 		// check for diagonal movement
-		if move.Source.File != move.Destination.File-1 &&
-			move.Source.File != move.Destination.File+1 {
+		_, sourceFile := pawnCoordinates(piece.Color, move.Source)
+		destinationRank, destinationFile := pawnCoordinates(piece.Color, move.Destination)
+		if sourceFile != destinationFile-1 &&
+			sourceFile != destinationFile+1 {
 			return false
 		}
 
-		switch piece.Color {
-		case WHITE:
-			return move.Source.Rank == f.bounds.RankCount-2 &&
-				move.Destination.Rank == f.bounds.RankCount-1
-		case BLACK:
-			return move.Source.Rank == 1 && move.Destination.Rank == 0
-		default:
-			return false
+		return f.IsPromotionRank(piece.Color, destinationRank)
+	default:
+		return true
+	}
+}
+
+// FilterMissingPromotion disallows pawns from reaching their promotion rank without promoting.
+type FilterMissingPromotion struct {
+	PromotionRanks
+}
+
+func (f *FilterMissingPromotion) IsLegalMove(move Move, state GameboardState) bool {
+	switch move.MoveType {
+	case NORMAL, CAPTURE, PAWN_DOUBLE_PUSH, EN_PASSANT:
+		piece := state[move.Source.Rank][move.Source.File]
+		if piece == nil || piece.PieceType != PAWN {
+			return true
 		}
+		rank, _ := pawnCoordinates(piece.Color, move.Destination)
+		return !f.IsPromotionRank(piece.Color, rank)
+	default:
+		return true
+	}
+}
+
+// DefaultPromotionPieceTypes returns the PieceTypes a pawn may be promoted to in classic chess.
+func DefaultPromotionPieceTypes() map[PieceType]bool {
+	return map[PieceType]bool{
+		QUEEN:  true,
+		ROOK:   true,
+		BISHOP: true,
+		KNIGHT: true,
+	}
+}
+
+// FilterIllegalPromotionPieceType disallows promotions to a PieceType the variant doesn't allow.
+type FilterIllegalPromotionPieceType struct {
+	PieceTypes map[PieceType]bool
+}
+
+func (f *FilterIllegalPromotionPieceType) IsLegalMove(move Move, state GameboardState) bool {
+	switch move.MoveType {
+	case PROMOTION, PROMOTION_CAPTURE:
+		// Moves without a choice are promoted to the default piece.
+		if move.PromotionPieceType == NONE {
+			return true
+		}
+		return f.PieceTypes[move.PromotionPieceType]
+	default:
+		return true
+	}
+}
+
+// FilterKingCapture disallows kings to capture, a capturing king would explode itself in atomic chess.
+type FilterKingCapture struct{}
+
+func (f *FilterKingCapture) IsLegalMove(move Move, state GameboardState) bool {
+	switch move.MoveType {
+	case CAPTURE, JUMP_CAPTURE, RIDE_CAPTURE, HOP_CAPTURE:
+		capturingPiece := state[move.Source.Rank][move.Source.File]
+		return capturingPiece == nil || capturingPiece.PieceType != KING
 	default:
 		return true
 	}
 }
+
+// FilterIllegalDrop disallows drops onto occupied squares and pawn drops onto the first or last rank.
+type FilterIllegalDrop struct {
+	Bounds
+}
+
+func (f *FilterIllegalDrop) IsLegalMove(move Move, state GameboardState) bool {
+	if move.MoveType != DROP {
+		return true
+	}
+	if !f.IsInboundsPosition(move.Destination) {
+		return false
+	}
+	if state[move.Destination.Rank][move.Destination.File] != nil {
+		return false
+	}
+	if move.DropPieceType == PAWN {
+		return move.Destination.Rank != 0 && move.Destination.Rank != f.RankCount-1
+	}
+	return move.DropPieceType != NONE
+}
@@ -114,6 +114,12 @@ func TestFilterOutOfBounds(t *testing.T) {
 
 func TestFilterPieceCollision(t *testing.T) {
 	bounds := Bounds{RankCount: 8, FileCount: 8}
+	voidBounds := Bounds{
+		RankCount: 8,
+		FileCount: 8,
+		Voids:     []Position{{Rank: 2, File: 0}, {Rank: 2, File: 1}, {Rank: 2, File: 2}},
+	}
+	cylinderBounds := Bounds{RankCount: 8, FileCount: 8, Topology: TopologyCylinder}
 	testcases := []struct {
 		name                string
 		filter              FilterPieceCollision
@@ -216,6 +222,314 @@ func TestFilterPieceCollision(t *testing.T) {
 			},
 			expectedIsLegalMove: false,
 		},
+		{
+			name:   "Jump collide not allowed at destination.",
+			filter: FilterPieceCollision{},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					5: {
+						5: NewKnight(BLACK),
+					},
+					7: {
+						4: NewKing(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 5, File: 5},
+					Destination: Position{Rank: 7, File: 4},
+					MoveType:    JUMP,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name:   "Jump to an empty square allowed.",
+			filter: FilterPieceCollision{},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					5: {
+						5: NewKnight(BLACK),
+					},
+					6: {
+						4: NewPawn(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 5, File: 5},
+					Destination: Position{Rank: 7, File: 4},
+					MoveType:    JUMP,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+		{
+			name:   "Ride over empty leaps allowed.",
+			filter: FilterPieceCollision{},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewKnight(WHITE),
+					},
+					1: {
+						1: NewPawn(BLACK),
+					},
+					6: {
+						3: NewPawn(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 4, File: 2},
+					MoveType:    RIDE,
+				},
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 6, File: 3},
+					MoveType:    RIDE_CAPTURE,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+		{
+			name:   "Ride collide not allowed.",
+			filter: FilterPieceCollision{},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewKnight(WHITE),
+					},
+					2: {
+						1: NewPawn(BLACK),
+					},
+					6: {
+						3: NewPawn(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 4, File: 2},
+					MoveType:    RIDE,
+				},
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 6, File: 3},
+					MoveType:    RIDE,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name:   "Hop over a screen allowed.",
+			filter: FilterPieceCollision{},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewQueen(WHITE, bounds),
+					},
+					3: {
+						0: NewPawn(WHITE),
+						3: NewPawn(BLACK),
+					},
+					4: {
+						0: NewPawn(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 4, File: 4},
+					MoveType:    HOP,
+				},
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 4, File: 0},
+					MoveType:    HOP_CAPTURE,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+		{
+			name:   "Hop without a screen right before the destination not allowed.",
+			filter: FilterPieceCollision{},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewQueen(WHITE, bounds),
+					},
+					2: {
+						0: NewPawn(WHITE),
+					},
+					6: {
+						0: NewPawn(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 4, File: 0},
+					MoveType:    HOP,
+				},
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 6, File: 0},
+					MoveType:    HOP_CAPTURE,
+				},
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 2, File: 2},
+					MoveType:    HOP,
+				},
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 2, File: 1},
+					MoveType:    HOP,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name:   "Ray through a void not allowed.",
+			filter: FilterPieceCollision{Bounds: voidBounds},
+			state: NewGameboardState(
+				voidBounds,
+				GameboardState{
+					0: {
+						0: NewRook(WHITE, voidBounds),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 4, File: 0},
+					MoveType:    NORMAL,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name:   "Ride through a void not allowed.",
+			filter: FilterPieceCollision{Bounds: voidBounds},
+			state: NewGameboardState(
+				voidBounds,
+				GameboardState{
+					0: {
+						0: NewPiece(WHITE, KNIGHT, NewRiderMoveGenerator(2, 1, voidBounds)),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 4, File: 2},
+					MoveType:    RIDE,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name:   "Hop over a void not allowed.",
+			filter: FilterPieceCollision{Bounds: voidBounds},
+			state: NewGameboardState(
+				voidBounds,
+				GameboardState{
+					0: {
+						2: NewRook(WHITE, voidBounds),
+					},
+					3: {
+						2: NewRook(BLACK, voidBounds),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 2},
+					Destination: Position{Rank: 4, File: 2},
+					MoveType:    HOP,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name:   "Ray wraps around a cylinder past blocking pieces.",
+			filter: FilterPieceCollision{Bounds: cylinderBounds},
+			state: NewGameboardState(
+				cylinderBounds,
+				GameboardState{
+					0: {
+						3: NewPawn(WHITE),
+						6: NewRook(WHITE, cylinderBounds),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 6},
+					Destination: Position{Rank: 0, File: 1},
+					MoveType:    NORMAL,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+		{
+			name:   "Ray blocked both ways around a cylinder.",
+			filter: FilterPieceCollision{Bounds: cylinderBounds},
+			state: NewGameboardState(
+				cylinderBounds,
+				GameboardState{
+					0: {
+						3: NewPawn(WHITE),
+						6: NewRook(WHITE, cylinderBounds),
+						7: NewPawn(WHITE),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 6},
+					Destination: Position{Rank: 0, File: 1},
+					MoveType:    NORMAL,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name:   "Hop across the edge of a cylinder.",
+			filter: FilterPieceCollision{Bounds: cylinderBounds},
+			state: NewGameboardState(
+				cylinderBounds,
+				GameboardState{
+					0: {
+						6: NewPiece(WHITE, KNIGHT, NewHopperMoveGenerator(East, cylinderBounds)),
+						7: NewPawn(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 6},
+					Destination: Position{Rank: 0, File: 0},
+					MoveType:    HOP,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
 		{
 			name:   "Unsupported move types.",
 			filter: FilterPieceCollision{},
@@ -382,7 +696,7 @@ func TestFilterInvalidPawnDoublePush(t *testing.T) {
 	}{
 		{
 			name:   "Pawn double push at initial position allowed.",
-			filter: FilterInvalidPawnDoublePush{},
+			filter: FilterInvalidPawnDoublePush{DoublePushRanks: NewDefaultDoublePushRanks(bounds)},
 			state: NewGameboardState(
 				bounds,
 				GameboardState{
@@ -410,7 +724,7 @@ func TestFilterInvalidPawnDoublePush(t *testing.T) {
 		},
 		{
 			name:   "Pawn double push not at initial position not allowed.",
-			filter: FilterInvalidPawnDoublePush{},
+			filter: FilterInvalidPawnDoublePush{DoublePushRanks: NewDefaultDoublePushRanks(bounds)},
 			state: NewGameboardState(
 				bounds,
 				GameboardState{
@@ -437,19 +751,47 @@ func TestFilterInvalidPawnDoublePush(t *testing.T) {
 			expectedIsLegalMove: false,
 		},
 		{
-			name:   "Unsupported move types.",
-			filter: FilterInvalidPawnDoublePush{},
-			state:  GameboardState{},
+			name:   "Pawn double push without double push ranks not allowed.",
+			filter: FilterInvalidPawnDoublePush{DoublePushRanks: DoublePushRanks{}},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					1: {
+						0: NewPawn(WHITE),
+					},
+					6: {
+						0: NewPawn(BLACK),
+					},
+				},
+			),
 			moves: []Move{
 				{
-					Source:      Position{Rank: 0, File: 1},
-					Destination: Position{Rank: 0, File: 2},
-					MoveType:    NORMAL,
+					Source:      Position{Rank: 1, File: 0},
+					Destination: Position{Rank: 3, File: 0},
+					MoveType:    PAWN_DOUBLE_PUSH,
 				},
 				{
-					Source:      Position{Rank: 0, File: 1},
-					Destination: Position{Rank: 0, File: 2},
-					MoveType:    CAPTURE,
+					Source:      Position{Rank: 6, File: 0},
+					Destination: Position{Rank: 4, File: 0},
+					MoveType:    PAWN_DOUBLE_PUSH,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name:   "Unsupported move types.",
+			filter: FilterInvalidPawnDoublePush{DoublePushRanks: NewDefaultDoublePushRanks(bounds)},
+			state:  GameboardState{},
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 1},
+					Destination: Position{Rank: 0, File: 2},
+					MoveType:    NORMAL,
+				},
+				{
+					Source:      Position{Rank: 0, File: 1},
+					Destination: Position{Rank: 0, File: 2},
+					MoveType:    CAPTURE,
 				},
 				{
 					Source:      Position{Rank: 0, File: 1},
@@ -850,3 +1192,733 @@ func TestFilterIllegalQueensideCastle(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterIllegalEnPassant(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	testcases := []struct {
+		name                string
+		filter              FilterIllegalEnPassant
+		state               GameboardState
+		moves               []Move
+		expectedIsLegalMove bool
+	}{
+		{
+			name: "En passant on the target square allowed.",
+			filter: FilterIllegalEnPassant{
+				EnPassantState: &EnPassantState{
+					Target:   &Position{Rank: 5, File: 3},
+					Captured: &Position{Rank: 4, File: 3},
+				},
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					4: {
+						2: NewPawn(WHITE),
+						3: NewPawn(BLACK),
+						4: NewPawn(WHITE),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 4, File: 2},
+					Destination: Position{Rank: 5, File: 3},
+					MoveType:    EN_PASSANT,
+				},
+				{
+					Source:      Position{Rank: 4, File: 4},
+					Destination: Position{Rank: 5, File: 3},
+					MoveType:    EN_PASSANT,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+		{
+			name: "En passant without a target not allowed.",
+			filter: FilterIllegalEnPassant{
+				EnPassantState: NewDefaultEnPassantState(),
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					4: {
+						2: NewPawn(WHITE),
+						3: NewPawn(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 4, File: 2},
+					Destination: Position{Rank: 5, File: 3},
+					MoveType:    EN_PASSANT,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name: "En passant off the target square not allowed.",
+			filter: FilterIllegalEnPassant{
+				EnPassantState: &EnPassantState{
+					Target:   &Position{Rank: 5, File: 3},
+					Captured: &Position{Rank: 4, File: 3},
+				},
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					4: {
+						2: NewPawn(WHITE),
+						3: NewPawn(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 4, File: 2},
+					Destination: Position{Rank: 5, File: 1},
+					MoveType:    EN_PASSANT,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name: "En passant of a friendly pawn not allowed.",
+			filter: FilterIllegalEnPassant{
+				EnPassantState: &EnPassantState{
+					Target:   &Position{Rank: 2, File: 3},
+					Captured: &Position{Rank: 3, File: 3},
+				},
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					3: {
+						2: NewPawn(WHITE),
+						3: NewPawn(WHITE),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 3, File: 2},
+					Destination: Position{Rank: 2, File: 3},
+					MoveType:    EN_PASSANT,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name: "Unsupported move types.",
+			filter: FilterIllegalEnPassant{
+				EnPassantState: NewDefaultEnPassantState(),
+			},
+			state: GameboardState{},
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 1},
+					Destination: Position{Rank: 0, File: 2},
+					MoveType:    NORMAL,
+				},
+				{
+					Source:      Position{Rank: 0, File: 1},
+					Destination: Position{Rank: 0, File: 2},
+					MoveType:    CAPTURE,
+				},
+				{
+					Source:      Position{Rank: 1, File: 1},
+					Destination: Position{Rank: 3, File: 1},
+					MoveType:    PAWN_DOUBLE_PUSH,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, move := range tc.moves {
+				isLegalMove := tc.filter.IsLegalMove(move, tc.state)
+				assert.Equal(t, tc.expectedIsLegalMove, isLegalMove)
+			}
+		})
+	}
+}
+
+func TestFilterIllegalPromotionPieceType(t *testing.T) {
+	testcases := []struct {
+		name                string
+		filter              FilterIllegalPromotionPieceType
+		moves               []Move
+		expectedIsLegalMove bool
+	}{
+		{
+			name: "Promotion to an allowed piece.",
+			filter: FilterIllegalPromotionPieceType{
+				PieceTypes: DefaultPromotionPieceTypes(),
+			},
+			moves: []Move{
+				{MoveType: PROMOTION, PromotionPieceType: QUEEN},
+				{MoveType: PROMOTION, PromotionPieceType: ROOK},
+				{MoveType: PROMOTION_CAPTURE, PromotionPieceType: BISHOP},
+				{MoveType: PROMOTION_CAPTURE, PromotionPieceType: KNIGHT},
+			},
+			expectedIsLegalMove: true,
+		},
+		{
+			name: "Promotion without a choice.",
+			filter: FilterIllegalPromotionPieceType{
+				PieceTypes: DefaultPromotionPieceTypes(),
+			},
+			moves: []Move{
+				{MoveType: PROMOTION},
+				{MoveType: PROMOTION_CAPTURE},
+			},
+			expectedIsLegalMove: true,
+		},
+		{
+			name: "Promotion to a forbidden piece.",
+			filter: FilterIllegalPromotionPieceType{
+				PieceTypes: DefaultPromotionPieceTypes(),
+			},
+			moves: []Move{
+				{MoveType: PROMOTION, PromotionPieceType: KING},
+				{MoveType: PROMOTION, PromotionPieceType: PAWN},
+				{MoveType: PROMOTION_CAPTURE, PromotionPieceType: KING},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name: "Promotion to a piece forbidden by the variant.",
+			filter: FilterIllegalPromotionPieceType{
+				PieceTypes: map[PieceType]bool{QUEEN: true},
+			},
+			moves: []Move{
+				{MoveType: PROMOTION, PromotionPieceType: KNIGHT},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name: "Unsupported move types.",
+			filter: FilterIllegalPromotionPieceType{
+				PieceTypes: map[PieceType]bool{},
+			},
+			moves: []Move{
+				{MoveType: NORMAL, PromotionPieceType: KING},
+				{MoveType: CAPTURE},
+			},
+			expectedIsLegalMove: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, move := range tc.moves {
+				isLegalMove := tc.filter.IsLegalMove(move, GameboardState{})
+				assert.Equal(t, tc.expectedIsLegalMove, isLegalMove)
+			}
+		})
+	}
+}
+
+func TestFilterIllegalPromotion(t *testing.T) {
+	bounds := Bounds{RankCount: 6, FileCount: 6}
+	testcases := []struct {
+		name                string
+		filter              FilterIllegalPromotion
+		state               GameboardState
+		moves               []Move
+		expectedIsLegalMove bool
+	}{
+		{
+			name: "Promotion on the promotion rank allowed.",
+			filter: FilterIllegalPromotion{
+				PromotionRanks: NewDefaultPromotionRanks(bounds),
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					4: {
+						0: NewPawn(WHITE),
+					},
+					1: {
+						0: NewPawn(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 4, File: 0},
+					Destination: Position{Rank: 5, File: 0},
+					MoveType:    PROMOTION,
+				},
+				{
+					Source:      Position{Rank: 1, File: 0},
+					Destination: Position{Rank: 0, File: 0},
+					MoveType:    PROMOTION,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+		{
+			name: "Promotion off the promotion rank not allowed.",
+			filter: FilterIllegalPromotion{
+				PromotionRanks: NewDefaultPromotionRanks(bounds),
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					3: {
+						0: NewPawn(WHITE),
+					},
+					2: {
+						0: NewPawn(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 3, File: 0},
+					Destination: Position{Rank: 4, File: 0},
+					MoveType:    PROMOTION,
+				},
+				{
+					Source:      Position{Rank: 2, File: 0},
+					Destination: Position{Rank: 1, File: 0},
+					MoveType:    PROMOTION,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name: "Promotion onto an occupied square not allowed.",
+			filter: FilterIllegalPromotion{
+				PromotionRanks: NewDefaultPromotionRanks(bounds),
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					4: {
+						0: NewPawn(WHITE),
+					},
+					5: {
+						0: NewRook(BLACK, bounds),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 4, File: 0},
+					Destination: Position{Rank: 5, File: 0},
+					MoveType:    PROMOTION,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name: "Promotion of a non-pawn not allowed.",
+			filter: FilterIllegalPromotion{
+				PromotionRanks: NewDefaultPromotionRanks(bounds),
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					4: {
+						0: NewRook(WHITE, bounds),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 4, File: 0},
+					Destination: Position{Rank: 5, File: 0},
+					MoveType:    PROMOTION,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, move := range tc.moves {
+				isLegalMove := tc.filter.IsLegalMove(move, tc.state)
+				assert.Equal(t, tc.expectedIsLegalMove, isLegalMove)
+			}
+		})
+	}
+}
+
+func TestFilterIllegalPromotionCapture(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 10}
+	testcases := []struct {
+		name                string
+		filter              FilterIllegalPromotionCapture
+		state               GameboardState
+		moves               []Move
+		expectedIsLegalMove bool
+	}{
+		{
+			name: "Promotion capture on the promotion rank allowed.",
+			filter: FilterIllegalPromotionCapture{
+				PromotionRanks: NewDefaultPromotionRanks(bounds),
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					6: {
+						8: NewPawn(WHITE),
+					},
+					7: {
+						9: NewRook(BLACK, bounds),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 6, File: 8},
+					Destination: Position{Rank: 7, File: 9},
+					MoveType:    PROMOTION_CAPTURE,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+		{
+			name: "Promotion capture of an empty square not allowed.",
+			filter: FilterIllegalPromotionCapture{
+				PromotionRanks: NewDefaultPromotionRanks(bounds),
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					6: {
+						8: NewPawn(WHITE),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 6, File: 8},
+					Destination: Position{Rank: 7, File: 9},
+					MoveType:    PROMOTION_CAPTURE,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name: "Promotion capture of a friendly piece not allowed.",
+			filter: FilterIllegalPromotionCapture{
+				PromotionRanks: NewDefaultPromotionRanks(bounds),
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					1: {
+						1: NewPawn(BLACK),
+					},
+					0: {
+						0: NewRook(BLACK, bounds),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 1, File: 1},
+					Destination: Position{Rank: 0, File: 0},
+					MoveType:    PROMOTION_CAPTURE,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, move := range tc.moves {
+				isLegalMove := tc.filter.IsLegalMove(move, tc.state)
+				assert.Equal(t, tc.expectedIsLegalMove, isLegalMove)
+			}
+		})
+	}
+}
+
+func TestFilterMissingPromotion(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	testcases := []struct {
+		name                string
+		filter              FilterMissingPromotion
+		state               GameboardState
+		moves               []Move
+		expectedIsLegalMove bool
+	}{
+		{
+			name: "Pawn reaching the promotion rank without promoting not allowed.",
+			filter: FilterMissingPromotion{
+				PromotionRanks: NewDefaultPromotionRanks(bounds),
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					6: {
+						0: NewPawn(WHITE),
+					},
+					1: {
+						0: NewPawn(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 6, File: 0},
+					Destination: Position{Rank: 7, File: 0},
+					MoveType:    NORMAL,
+				},
+				{
+					Source:      Position{Rank: 6, File: 0},
+					Destination: Position{Rank: 7, File: 1},
+					MoveType:    CAPTURE,
+				},
+				{
+					Source:      Position{Rank: 1, File: 0},
+					Destination: Position{Rank: 0, File: 0},
+					MoveType:    NORMAL,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name: "Pawn moving off the promotion rank allowed.",
+			filter: FilterMissingPromotion{
+				PromotionRanks: NewDefaultPromotionRanks(bounds),
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					5: {
+						0: NewPawn(WHITE),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 5, File: 0},
+					Destination: Position{Rank: 6, File: 0},
+					MoveType:    NORMAL,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+		{
+			name: "Non-pawn reaching the promotion rank allowed.",
+			filter: FilterMissingPromotion{
+				PromotionRanks: NewDefaultPromotionRanks(bounds),
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					6: {
+						0: NewRook(WHITE, bounds),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 6, File: 0},
+					Destination: Position{Rank: 7, File: 0},
+					MoveType:    NORMAL,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, move := range tc.moves {
+				isLegalMove := tc.filter.IsLegalMove(move, tc.state)
+				assert.Equal(t, tc.expectedIsLegalMove, isLegalMove)
+			}
+		})
+	}
+}
+
+func TestFilterKingCapture(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	testcases := []struct {
+		name                string
+		state               GameboardState
+		moves               []Move
+		expectedIsLegalMove bool
+	}{
+		{
+			name: "King capture not allowed.",
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewKing(WHITE),
+						1: NewRook(BLACK, bounds),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 0, File: 1},
+					MoveType:    CAPTURE,
+				},
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 0, File: 1},
+					MoveType:    JUMP_CAPTURE,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name: "King move allowed.",
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewKing(WHITE),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 0, File: 1},
+					MoveType:    NORMAL,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+		{
+			name: "Other piece capture allowed.",
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewQueen(WHITE, bounds),
+						1: NewRook(BLACK, bounds),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Source:      Position{Rank: 0, File: 0},
+					Destination: Position{Rank: 0, File: 1},
+					MoveType:    CAPTURE,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := FilterKingCapture{}
+			for _, move := range tc.moves {
+				isLegalMove := filter.IsLegalMove(move, tc.state)
+				assert.Equal(t, tc.expectedIsLegalMove, isLegalMove)
+			}
+		})
+	}
+}
+
+func TestFilterIllegalDrop(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	testcases := []struct {
+		name                string
+		state               GameboardState
+		moves               []Move
+		expectedIsLegalMove bool
+	}{
+		{
+			name:  "Drop on an empty square allowed.",
+			state: NewGameboardState(bounds, GameboardState{}),
+			moves: []Move{
+				{
+					Destination:   Position{Rank: 0, File: 0},
+					MoveType:      DROP,
+					DropPieceType: KNIGHT,
+				},
+				{
+					Destination:   Position{Rank: 1, File: 0},
+					MoveType:      DROP,
+					DropPieceType: PAWN,
+				},
+				{
+					Destination:   Position{Rank: 6, File: 0},
+					MoveType:      DROP,
+					DropPieceType: PAWN,
+				},
+			},
+			expectedIsLegalMove: true,
+		},
+		{
+			name: "Drop on an occupied square not allowed.",
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					3: {
+						3: NewPawn(BLACK),
+					},
+				},
+			),
+			moves: []Move{
+				{
+					Destination:   Position{Rank: 3, File: 3},
+					MoveType:      DROP,
+					DropPieceType: KNIGHT,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name:  "Pawn drop on the first or last rank not allowed.",
+			state: NewGameboardState(bounds, GameboardState{}),
+			moves: []Move{
+				{
+					Destination:   Position{Rank: 0, File: 0},
+					MoveType:      DROP,
+					DropPieceType: PAWN,
+				},
+				{
+					Destination:   Position{Rank: 7, File: 0},
+					MoveType:      DROP,
+					DropPieceType: PAWN,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+		{
+			name:  "Drop out of bounds or without a piece not allowed.",
+			state: NewGameboardState(bounds, GameboardState{}),
+			moves: []Move{
+				{
+					Destination:   Position{Rank: 8, File: 0},
+					MoveType:      DROP,
+					DropPieceType: KNIGHT,
+				},
+				{
+					Destination: Position{Rank: 3, File: 3},
+					MoveType:    DROP,
+				},
+			},
+			expectedIsLegalMove: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := FilterIllegalDrop{Bounds: bounds}
+			for _, move := range tc.moves {
+				isLegalMove := filter.IsLegalMove(move, tc.state)
+				assert.Equal(t, tc.expectedIsLegalMove, isLegalMove)
+			}
+		})
+	}
+}
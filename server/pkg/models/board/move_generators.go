@@ -14,6 +14,20 @@ func (g *SingleNormalMoveGenerator) GenerateMoves(source Position) MoveMap {
 	}
 }
 
+// SingleCaptureMoveGenerator generates CAPTURE moves of one square
+// in a single direction.
+type SingleCaptureMoveGenerator struct {
+	direction Direction
+}
+
+func (g *SingleCaptureMoveGenerator) GenerateMoves(source Position) MoveMap {
+	return map[MoveType][]Position{
+		CAPTURE: {
+			StepInDirection(source, g.direction),
+		},
+	}
+}
+
 // SingleDiagonalCaputureMoveGenerator generates CAPTURE moves of one square
 // in each of the forward two diagonal directions.
 type SingleDiagonalCaputureMoveGenerator struct {
@@ -21,18 +35,8 @@ type SingleDiagonalCaputureMoveGenerator struct {
 }
 
 func (g *SingleDiagonalCaputureMoveGenerator) GenerateMoves(source Position) MoveMap {
-	var rankDirection int
-	if g.color == WHITE {
-		rankDirection = 1
-	} else {
-		rankDirection = -1
-	}
-
 	return map[MoveType][]Position{
-		CAPTURE: {
-			{Rank: source.Rank + rankDirection, File: source.File - 1},
-			{Rank: source.Rank + rankDirection, File: source.File + 1},
-		},
+		CAPTURE: stepInDirections(source, pawnCaptureDirections(g.color)),
 	}
 }
 
@@ -56,18 +60,20 @@ type PromotionCaptureMoveGenerator struct {
 }
 
 func (g *PromotionCaptureMoveGenerator) GenerateMoves(source Position) MoveMap {
-	var rankDirection int
-	if g.color == WHITE {
-		rankDirection = 1
-	} else {
-		rankDirection = -1
+	return map[MoveType][]Position{
+		PROMOTION_CAPTURE: stepInDirections(source, pawnCaptureDirections(g.color)),
 	}
+}
 
+// EnPassantMoveGenerator generates EN_PASSANT moves of one square
+// in each of the forward two diagonal directions.
+type EnPassantMoveGenerator struct {
+	color Color
+}
+
+func (g *EnPassantMoveGenerator) GenerateMoves(source Position) MoveMap {
 	return map[MoveType][]Position{
-		PROMOTION_CAPTURE: {
-			{Rank: source.Rank + rankDirection, File: source.File - 1},
-			{Rank: source.Rank + rankDirection, File: source.File + 1},
-		},
+		EN_PASSANT: stepInDirections(source, pawnCaptureDirections(g.color)),
 	}
 }
 
@@ -78,16 +84,10 @@ type DoublePushMoveGenerator struct {
 }
 
 func (g *DoublePushMoveGenerator) GenerateMoves(source Position) MoveMap {
-	var rankDirection int
-	if g.color == WHITE {
-		rankDirection = 1
-	} else {
-		rankDirection = -1
-	}
-
+	direction := pawnDirection(g.color)
 	return map[MoveType][]Position{
 		PAWN_DOUBLE_PUSH: {
-			{Rank: source.Rank + rankDirection*2, File: source.File},
+			StepInDirection(StepInDirection(source, direction), direction),
 		},
 	}
 }
@@ -136,52 +136,6 @@ func (g *RayMoveGenerator) GenerateMoves(source Position) MoveMap {
 	}
 }
 
-// CastleMoveGenerator generates KINGSIDE_CASTLE and QUEENSIDE_CASTLE moves.
-type CastleMoveGenerator struct {
-	color Color
-}
-
-func (g *CastleMoveGenerator) GenerateMoves(source Position) MoveMap {
-	moves := map[MoveType][]Position{
-		KINGSIDE_CASTLE:  {},
-		QUEENSIDE_CASTLE: {},
-	}
-
-	castleOptions := []struct {
-		requiredColor Color
-		moveType      MoveType
-		destination   Position
-	}{
-		{
-			WHITE,
-			KINGSIDE_CASTLE,
-			Position{Rank: 0, File: 6},
-		},
-		{
-			WHITE,
-			QUEENSIDE_CASTLE,
-			Position{Rank: 0, File: 2},
-		},
-		{
-			BLACK,
-			KINGSIDE_CASTLE,
-			Position{Rank: 7, File: 6},
-		},
-		{
-			BLACK,
-			QUEENSIDE_CASTLE,
-			Position{Rank: 7, File: 2},
-		},
-	}
-	for _, option := range castleOptions {
-		if option.requiredColor == g.color {
-			moves[option.moveType] = append(moves[option.moveType], option.destination)
-		}
-	}
-
-	return moves
-}
-
 // StepInDirection returns a Position one move in the direction from the source Position.
 func StepInDirection(source Position, direction Direction) Position {
 	nextPosition := source
@@ -212,19 +166,163 @@ func StepInDirection(source Position, direction Direction) Position {
 	return nextPosition
 }
 
+// stepInDirections returns the Positions one move in each of the directions from the source Position.
+func stepInDirections(source Position, directions []Direction) []Position {
+	positions := make([]Position, 0, len(directions))
+	for _, direction := range directions {
+		positions = append(positions, StepInDirection(source, direction))
+	}
+	return positions
+}
+
 // GenerateRay generates a list of Positions by moving from the source in the provided direction,
-// it continues until a board boundary is encountered.
+// it continues until a board boundary is encountered or, on wrapping boards, the ray returns to the source.
 func GenerateRay(source Position, direction Direction, bounds Bounds) []Position {
 	positionList := []Position{}
 
 	nextPosition := source
 	for {
-		nextPosition = StepInDirection(nextPosition, direction)
+		nextPosition = bounds.StepInDirection(nextPosition, direction)
 
-		if !bounds.IsInboundsPosition(nextPosition) {
+		if !bounds.IsInboundsPosition(nextPosition) || nextPosition == source {
 			return positionList
 		}
 
 		positionList = append(positionList, nextPosition)
 	}
 }
+
+// leap is the offset of a move in ranks and files.
+type leap struct {
+	rank int
+	file int
+}
+
+// leapsOf returns the distinct leaps of m squares in one direction
+// and n squares in an orthogonal direction.
+func leapsOf(m, n int) []leap {
+	leaps := []leap{}
+	seen := map[leap]bool{}
+	for _, l := range []leap{
+		{rank: m, file: n}, {rank: m, file: -n}, {rank: -m, file: n}, {rank: -m, file: -n},
+		{rank: n, file: m}, {rank: n, file: -m}, {rank: -n, file: m}, {rank: -n, file: -m},
+	} {
+		if seen[l] || l == (leap{}) {
+			continue
+		}
+		seen[l] = true
+		leaps = append(leaps, l)
+	}
+	return leaps
+}
+
+// LeaperMoveGenerator generates JUMP and JUMP_CAPTURE moves of m squares in one direction
+// and n squares in an orthogonal direction, e.g. (3, 1) for a camel.
+type LeaperMoveGenerator struct {
+	m int
+	n int
+}
+
+// NewLeaperMoveGenerator creates a LeaperMoveGenerator of the (m, n) leap.
+func NewLeaperMoveGenerator(m, n int) *LeaperMoveGenerator {
+	return &LeaperMoveGenerator{m: m, n: n}
+}
+
+func (g *LeaperMoveGenerator) GenerateMoves(source Position) MoveMap {
+	moves := map[MoveType][]Position{
+		JUMP:         {},
+		JUMP_CAPTURE: {},
+	}
+
+	for _, l := range leapsOf(g.m, g.n) {
+		nextPosition := Position{Rank: source.Rank + l.rank, File: source.File + l.file}
+		moves[JUMP] = append(moves[JUMP], nextPosition)
+		moves[JUMP_CAPTURE] = append(moves[JUMP_CAPTURE], nextPosition)
+	}
+
+	return moves
+}
+
+// RiderMoveGenerator generates RIDE and RIDE_CAPTURE moves repeating an (m, n) leap
+// in the same direction until a board boundary or the source, e.g. (2, 1) for a nightrider.
+// The leap can't be a multiple of a shorter leap, e.g. (2, 0).
+type RiderMoveGenerator struct {
+	m      int
+	n      int
+	bounds Bounds
+}
+
+// NewRiderMoveGenerator creates a RiderMoveGenerator of the (m, n) leap.
+func NewRiderMoveGenerator(m, n int, bounds Bounds) *RiderMoveGenerator {
+	return &RiderMoveGenerator{m: m, n: n, bounds: bounds}
+}
+
+func (g *RiderMoveGenerator) GenerateMoves(source Position) MoveMap {
+	moves := map[MoveType][]Position{
+		RIDE:         {},
+		RIDE_CAPTURE: {},
+	}
+
+	for _, l := range leapsOf(g.m, g.n) {
+		for _, nextPosition := range g.ride(source, l) {
+			moves[RIDE] = append(moves[RIDE], nextPosition)
+			moves[RIDE_CAPTURE] = append(moves[RIDE_CAPTURE], nextPosition)
+		}
+	}
+
+	return moves
+}
+
+// ride returns the Positions reached by repeating the leap from the source,
+// wrapped across the edges of the bounds.
+func (g *RiderMoveGenerator) ride(source Position, l leap) []Position {
+	positions := []Position{}
+	nextPosition := source
+	for {
+		nextPosition = g.bounds.Wrap(Position{Rank: nextPosition.Rank + l.rank, File: nextPosition.File + l.file})
+		if !g.bounds.IsInboundsPosition(nextPosition) || nextPosition == source {
+			return positions
+		}
+		positions = append(positions, nextPosition)
+	}
+}
+
+// HopperMoveGenerator generates HOP and HOP_CAPTURE moves in a single direction,
+// the piece hops over the first piece in its way to the square right behind it, e.g. a grasshopper.
+type HopperMoveGenerator struct {
+	direction Direction
+	bounds    Bounds
+}
+
+// NewHopperMoveGenerator creates a HopperMoveGenerator in the direction.
+func NewHopperMoveGenerator(direction Direction, bounds Bounds) *HopperMoveGenerator {
+	return &HopperMoveGenerator{direction: direction, bounds: bounds}
+}
+
+func (g *HopperMoveGenerator) GenerateMoves(source Position) MoveMap {
+	// A hop lands behind its screen, at least two squares away.
+	ray := GenerateRay(source, g.direction, g.bounds)
+	if len(ray) > 0 {
+		ray = ray[1:]
+	}
+	return map[MoveType][]Position{
+		HOP:         ray,
+		HOP_CAPTURE: ray,
+	}
+}
+
+// abs returns the absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// gcd returns the greatest common divisor of a and b.
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
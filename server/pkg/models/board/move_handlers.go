@@ -50,6 +50,10 @@ func (h *SinglePieceMoveApplicator) GetTypesToHandle() map[MoveType]bool {
 		JUMP:             true,
 		JUMP_CAPTURE:     true,
 		PAWN_DOUBLE_PUSH: true,
+		RIDE:             true,
+		RIDE_CAPTURE:     true,
+		HOP:              true,
+		HOP_CAPTURE:      true,
 	}
 }
 
@@ -70,7 +74,9 @@ func (h *SinglePieceMoveApplicator) ApplyMove(move Move, state GameboardState) e
 }
 
 // KingsideCastleMoveApplicator applies a kingside castle to a GameboardState.
-type KingsideCastleMoveApplicator struct{}
+type KingsideCastleMoveApplicator struct {
+	*CastlingState
+}
 
 func (h *KingsideCastleMoveApplicator) GetTypesToHandle() map[MoveType]bool {
 	return map[MoveType]bool{
@@ -83,45 +89,13 @@ func (h *KingsideCastleMoveApplicator) ApplyMove(move Move, state GameboardState
 		return errCannotHandleMoveType(move.MoveType)
 	}
 
-	// Check king present.
-	kingPiece := state[move.Source.Rank][move.Source.File]
-	if kingPiece == nil {
-		return errSourcePieceNotFound
-	}
-
-	// Check rook present.
-	switch kingPiece.GetColor() {
-	case WHITE:
-		rookPiece := state[0][7]
-		if rookPiece == nil {
-			return errSourcePieceNotFound
-		}
-	case BLACK:
-		rookPiece := state[7][7]
-		if rookPiece == nil {
-			return errSourcePieceNotFound
-		}
-	}
-
-	// Handle the king movement.
-	state[move.Destination.Rank][move.Destination.File] = kingPiece
-	state[move.Source.Rank][move.Source.File] = nil
-
-	// Handle the rook movement.
-	switch kingPiece.GetColor() {
-	case WHITE:
-		state[0][5] = state[0][7]
-		state[0][7] = nil
-	case BLACK:
-		state[7][5] = state[7][7]
-		state[7][7] = nil
-	}
-
-	return nil
+	return applyCastleMove(h.CastlingState, move, state)
 }
 
 // QueensideCastleMoveApplicator applies a queenside castle to a GameboardState.
-type QueensideCastleMoveApplicator struct{}
+type QueensideCastleMoveApplicator struct {
+	*CastlingState
+}
 
 func (h *QueensideCastleMoveApplicator) GetTypesToHandle() map[MoveType]bool {
 	return map[MoveType]bool{
@@ -133,46 +107,68 @@ func (h *QueensideCastleMoveApplicator) ApplyMove(move Move, state GameboardStat
 	if _, ok := h.GetTypesToHandle()[move.MoveType]; !ok {
 		return errCannotHandleMoveType(move.MoveType)
 	}
+
+	return applyCastleMove(h.CastlingState, move, state)
+}
+
+// applyCastleMove moves the king and rook as described by the move's CastlingRule.
+func applyCastleMove(castlingState *CastlingState, move Move, state GameboardState) error {
 	// Check king present.
 	kingPiece := state[move.Source.Rank][move.Source.File]
 	if kingPiece == nil {
 		return errSourcePieceNotFound
 	}
 
-	// Check rook present.
-	switch kingPiece.GetColor() {
-	case WHITE:
-		rookPiece := state[0][0]
-		if rookPiece == nil {
-			return errSourcePieceNotFound
-		}
-	case BLACK:
-		rookPiece := state[7][0]
-		if rookPiece == nil {
-			return errSourcePieceNotFound
-		}
+	rule := castlingState.getCastlingRule(move.MoveType, kingPiece.GetColor())
+	if rule == nil {
+		return errCastlingRuleNotFound
 	}
 
-	// Handle the king movement.
-	state[move.Destination.Rank][move.Destination.File] = kingPiece
-	state[move.Source.Rank][move.Source.File] = nil
+	return rule.applyCastle(state)
+}
+
+// EnPassantMoveApplicator applies an en passant capture to a GameboardState.
+type EnPassantMoveApplicator struct {
+	*EnPassantState
+}
+
+func (a *EnPassantMoveApplicator) GetTypesToHandle() map[MoveType]bool {
+	return map[MoveType]bool{
+		EN_PASSANT: true,
+	}
+}
+
+func (a *EnPassantMoveApplicator) ApplyMove(move Move, state GameboardState) error {
+	if _, ok := a.GetTypesToHandle()[move.MoveType]; !ok {
+		return errCannotHandleMoveType(move.MoveType)
+	}
 
-	// Handle the rook movement.
-	switch kingPiece.GetColor() {
-	case WHITE:
-		state[0][3] = state[0][0]
-		state[0][0] = nil
-	case BLACK:
-		state[7][3] = state[7][0]
-		state[7][0] = nil
+	// Check pawn is present.
+	pawnPiece := state[move.Source.Rank][move.Source.File]
+	if pawnPiece == nil {
+		return errSourcePieceNotFound
+	}
+
+	// Check captured pawn is present.
+	if a.Captured == nil || state[a.Captured.Rank][a.Captured.File] == nil {
+		return errCapturedPieceNotFound
 	}
 
+	// Handle the pawn movement.
+	state[move.Destination.Rank][move.Destination.File] = pawnPiece
+	state[move.Source.Rank][move.Source.File] = nil
+
+	// Remove the captured pawn.
+	state[a.Captured.Rank][a.Captured.File] = nil
+
 	return nil
 }
 
-// PromotionMoveApplicator applies a promotion to a GameboardState.
+// PromotionMoveApplicator applies a promotion to a GameboardState,
+// promoted pieces move following the PieceMovements.
 type PromotionMoveApplicator struct {
 	Bounds
+	PieceMovements
 }
 
 func (a *PromotionMoveApplicator) GetTypesToHandle() map[MoveType]bool {
@@ -193,12 +189,106 @@ func (a *PromotionMoveApplicator) ApplyMove(move Move, state GameboardState) err
 		return errSourcePieceNotFound
 	}
 
-	// Set the promoted pawn to be a queen.
+	// Promote to a queen unless another piece was chosen.
+	pieceType := move.PromotionPieceType
+	if pieceType == NONE {
+		pieceType = QUEEN
+	}
+
+	promotedPiece, err := a.NewPiece(pawnPiece.Color, pieceType, a.Bounds)
+	if err != nil {
+		return err
+	}
+
+	// Set the promoted pawn to be the chosen piece.
 	state[move.Source.Rank][move.Source.File] = nil
-	state[move.Destination.Rank][move.Destination.File] = NewQueen(
-		pawnPiece.Color,
-		a.Bounds,
-	)
+	state[move.Destination.Rank][move.Destination.File] = promotedPiece
+
+	return nil
+}
+
+// ExplosionMoveApplicator applies captures as explosions to a GameboardState,
+// the capturing piece and every piece but pawns next to the destination are removed with the captured piece.
+type ExplosionMoveApplicator struct {
+	Bounds
+	// CaptureApplicator moves the capturing piece before the explosion.
+	CaptureApplicator *MoveApplicator
+}
+
+func (a *ExplosionMoveApplicator) GetTypesToHandle() map[MoveType]bool {
+	return map[MoveType]bool{
+		CAPTURE:           true,
+		JUMP_CAPTURE:      true,
+		RIDE_CAPTURE:      true,
+		HOP_CAPTURE:       true,
+		EN_PASSANT:        true,
+		PROMOTION_CAPTURE: true,
+	}
+}
+
+func (a *ExplosionMoveApplicator) ApplyMove(move Move, state GameboardState) error {
+	if _, ok := a.GetTypesToHandle()[move.MoveType]; !ok {
+		return errCannotHandleMoveType(move.MoveType)
+	}
+
+	// Handle the capture.
+	capturedState, err := a.CaptureApplicator.ApplyMove(move, state)
+	if err != nil {
+		return err
+	}
+	for rank, files := range capturedState {
+		for file, piece := range files {
+			state[rank][file] = piece
+		}
+	}
+
+	// Explode the capturing piece and the surrounding pieces.
+	state[move.Destination.Rank][move.Destination.File] = nil
+	for rank := move.Destination.Rank - 1; rank <= move.Destination.Rank+1; rank++ {
+		for file := move.Destination.File - 1; file <= move.Destination.File+1; file++ {
+			position := Position{Rank: rank, File: file}
+			if !a.IsInboundsPosition(position) {
+				continue
+			}
+			piece := state[rank][file]
+			if piece != nil && piece.PieceType != PAWN {
+				state[rank][file] = nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// DropMoveApplicator applies a drop of a piece from the active player's reserve to a GameboardState,
+// dropped pieces move following the PieceMovements.
+type DropMoveApplicator struct {
+	Bounds
+	PieceMovements
+	*TurnState
+}
+
+func (a *DropMoveApplicator) GetTypesToHandle() map[MoveType]bool {
+	return map[MoveType]bool{
+		DROP: true,
+	}
+}
+
+func (a *DropMoveApplicator) ApplyMove(move Move, state GameboardState) error {
+	if _, ok := a.GetTypesToHandle()[move.MoveType]; !ok {
+		return errCannotHandleMoveType(move.MoveType)
+	}
+
+	// Check the destination is empty.
+	if state[move.Destination.Rank][move.Destination.File] != nil {
+		return errDropSquareOccupied
+	}
+
+	droppedPiece, err := a.NewPiece(a.GetActivePlayer(), move.DropPieceType, a.Bounds)
+	if err != nil {
+		return err
+	}
+	state[move.Destination.Rank][move.Destination.File] = droppedPiece
 
 	return nil
 }
@@ -154,7 +154,7 @@ func TestSinglePieceMoveApplicator(t *testing.T) {
 
 func TestKingsideCastleMoveApplicator(t *testing.T) {
 	bounds := Bounds{RankCount: 8, FileCount: 8}
-	moveApplicator := KingsideCastleMoveApplicator{}
+	moveApplicator := KingsideCastleMoveApplicator{CastlingState: NewDefaultCastlingState()}
 	testcases := []struct {
 		name          string
 		move          Move
@@ -360,7 +360,7 @@ func TestKingsideCastleMoveApplicator(t *testing.T) {
 
 func TestQueensideCastleMoveApplicator(t *testing.T) {
 	bounds := Bounds{RankCount: 8, FileCount: 8}
-	moveApplicator := QueensideCastleMoveApplicator{}
+	moveApplicator := QueensideCastleMoveApplicator{CastlingState: NewDefaultCastlingState()}
 	testcases := []struct {
 		name          string
 		move          Move
@@ -563,3 +563,494 @@ func TestQueensideCastleMoveApplicator(t *testing.T) {
 		})
 	}
 }
+
+func TestEnPassantMoveApplicator(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	testcases := []struct {
+		name           string
+		moveApplicator EnPassantMoveApplicator
+		move           Move
+		state          GameboardState
+		expectedState  GameboardState
+		expectedError  error
+	}{
+		{
+			name: "white en passant",
+			moveApplicator: EnPassantMoveApplicator{
+				EnPassantState: &EnPassantState{
+					Target:   &Position{Rank: 5, File: 3},
+					Captured: &Position{Rank: 4, File: 3},
+				},
+			},
+			move: Move{
+				Source:      Position{Rank: 4, File: 4},
+				Destination: Position{Rank: 5, File: 3},
+				MoveType:    EN_PASSANT,
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					4: {
+						3: NewPawn(BLACK),
+						4: NewPawn(WHITE),
+					},
+				},
+			),
+			expectedState: NewGameboardState(
+				bounds,
+				GameboardState{
+					5: {
+						3: NewPawn(WHITE),
+					},
+				},
+			),
+		},
+		{
+			name: "black en passant",
+			moveApplicator: EnPassantMoveApplicator{
+				EnPassantState: &EnPassantState{
+					Target:   &Position{Rank: 2, File: 6},
+					Captured: &Position{Rank: 3, File: 6},
+				},
+			},
+			move: Move{
+				Source:      Position{Rank: 3, File: 7},
+				Destination: Position{Rank: 2, File: 6},
+				MoveType:    EN_PASSANT,
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					3: {
+						6: NewPawn(WHITE),
+						7: NewPawn(BLACK),
+					},
+				},
+			),
+			expectedState: NewGameboardState(
+				bounds,
+				GameboardState{
+					2: {
+						6: NewPawn(BLACK),
+					},
+				},
+			),
+		},
+		{
+			name: "captured pawn missing",
+			moveApplicator: EnPassantMoveApplicator{
+				EnPassantState: NewDefaultEnPassantState(),
+			},
+			move: Move{
+				Source:      Position{Rank: 4, File: 4},
+				Destination: Position{Rank: 5, File: 3},
+				MoveType:    EN_PASSANT,
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					4: {
+						4: NewPawn(WHITE),
+					},
+				},
+			),
+			expectedState: NewGameboardState(
+				bounds,
+				GameboardState{
+					4: {
+						4: NewPawn(WHITE),
+					},
+				},
+			),
+			expectedError: errCapturedPieceNotFound,
+		},
+		{
+			name: "unsupport MoveType",
+			moveApplicator: EnPassantMoveApplicator{
+				EnPassantState: NewDefaultEnPassantState(),
+			},
+			move: Move{
+				Source:      Position{Rank: 1, File: 0},
+				Destination: Position{Rank: 2, File: 0},
+				MoveType:    NORMAL,
+			},
+			state:         NewGameboardState(bounds, GameboardState{}),
+			expectedState: NewGameboardState(bounds, GameboardState{}),
+			expectedError: errCannotHandleMoveType(NORMAL),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.moveApplicator.ApplyMove(tc.move, tc.state)
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedState, tc.state)
+		})
+	}
+}
+
+func TestPromotionMoveApplicator(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	moveApplicator := PromotionMoveApplicator{Bounds: bounds}
+	testcases := []struct {
+		name          string
+		move          Move
+		state         GameboardState
+		expectedState GameboardState
+		expectedError error
+	}{
+		{
+			name: "promotion without a choice",
+			move: Move{
+				Source:      Position{Rank: 6, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    PROMOTION,
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					6: {
+						0: NewPawn(WHITE),
+					},
+				},
+			),
+			expectedState: NewGameboardState(
+				bounds,
+				GameboardState{
+					7: {
+						0: NewQueen(WHITE, bounds),
+					},
+				},
+			),
+		},
+		{
+			name: "promotion to knight",
+			move: Move{
+				Source:             Position{Rank: 6, File: 0},
+				Destination:        Position{Rank: 7, File: 0},
+				MoveType:           PROMOTION,
+				PromotionPieceType: KNIGHT,
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					6: {
+						0: NewPawn(WHITE),
+					},
+				},
+			),
+			expectedState: NewGameboardState(
+				bounds,
+				GameboardState{
+					7: {
+						0: NewKnight(WHITE),
+					},
+				},
+			),
+		},
+		{
+			name: "promotion capture to rook",
+			move: Move{
+				Source:             Position{Rank: 1, File: 3},
+				Destination:        Position{Rank: 0, File: 4},
+				MoveType:           PROMOTION_CAPTURE,
+				PromotionPieceType: ROOK,
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						4: NewBishop(WHITE, bounds),
+					},
+					1: {
+						3: NewPawn(BLACK),
+					},
+				},
+			),
+			expectedState: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						4: NewRook(BLACK, bounds),
+					},
+				},
+			),
+		},
+		{
+			name: "invalid piece type",
+			move: Move{
+				Source:             Position{Rank: 6, File: 0},
+				Destination:        Position{Rank: 7, File: 0},
+				MoveType:           PROMOTION,
+				PromotionPieceType: PieceType(100),
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					6: {
+						0: NewPawn(WHITE),
+					},
+				},
+			),
+			expectedState: NewGameboardState(
+				bounds,
+				GameboardState{
+					6: {
+						0: NewPawn(WHITE),
+					},
+				},
+			),
+			expectedError: errInvalidPieceType(PieceType(100)),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := moveApplicator.ApplyMove(tc.move, tc.state)
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedState, tc.state)
+		})
+	}
+}
+
+func TestExplosionMoveApplicator(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	enPassantState := &EnPassantState{
+		Target:   &Position{Rank: 5, File: 3},
+		Captured: &Position{Rank: 4, File: 3},
+	}
+	moveApplicator := ExplosionMoveApplicator{
+		Bounds: bounds,
+		CaptureApplicator: NewMoveApplicator(
+			&SinglePieceMoveApplicator{},
+			&PromotionMoveApplicator{Bounds: bounds},
+			&EnPassantMoveApplicator{EnPassantState: enPassantState},
+		),
+	}
+	testcases := []struct {
+		name          string
+		move          Move
+		state         GameboardState
+		expectedState GameboardState
+		expectedError error
+	}{
+		{
+			name: "capture explodes the surrounding pieces but pawns",
+			move: Move{
+				Source:      Position{Rank: 0, File: 3},
+				Destination: Position{Rank: 6, File: 3},
+				MoveType:    CAPTURE,
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						3: NewQueen(WHITE, bounds),
+					},
+					6: {
+						2: NewPawn(BLACK),
+						3: NewPawn(BLACK),
+						4: NewPawn(BLACK),
+					},
+					7: {
+						2: NewBishop(BLACK, bounds),
+						3: NewQueen(BLACK, bounds),
+						4: NewKing(BLACK),
+						5: NewBishop(BLACK, bounds),
+					},
+				},
+			),
+			expectedState: NewGameboardState(
+				bounds,
+				GameboardState{
+					6: {
+						2: NewPawn(BLACK),
+						4: NewPawn(BLACK),
+					},
+					7: {
+						5: NewBishop(BLACK, bounds),
+					},
+				},
+			),
+		},
+		{
+			name: "jump capture explodes friendly pieces",
+			move: Move{
+				Source:      Position{Rank: 0, File: 1},
+				Destination: Position{Rank: 2, File: 2},
+				MoveType:    JUMP_CAPTURE,
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						1: NewKnight(WHITE),
+					},
+					1: {
+						3: NewBishop(WHITE, bounds),
+					},
+					2: {
+						2: NewKnight(BLACK),
+					},
+				},
+			),
+			expectedState: NewGameboardState(bounds, GameboardState{}),
+		},
+		{
+			name: "en passant explodes around the destination",
+			move: Move{
+				Source:      Position{Rank: 4, File: 4},
+				Destination: Position{Rank: 5, File: 3},
+				MoveType:    EN_PASSANT,
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					4: {
+						3: NewPawn(BLACK),
+						4: NewPawn(WHITE),
+					},
+					6: {
+						2: NewKnight(BLACK),
+						3: NewPawn(BLACK),
+					},
+				},
+			),
+			expectedState: NewGameboardState(
+				bounds,
+				GameboardState{
+					6: {
+						3: NewPawn(BLACK),
+					},
+				},
+			),
+		},
+		{
+			name: "explosion at the edge of the board",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    CAPTURE,
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewRook(WHITE, bounds),
+					},
+					7: {
+						0: NewRook(BLACK, bounds),
+						1: NewKnight(BLACK),
+					},
+				},
+			),
+			expectedState: NewGameboardState(bounds, GameboardState{}),
+		},
+		{
+			name: "non capture move type",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 1, File: 0},
+				MoveType:    NORMAL,
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewRook(WHITE, bounds),
+					},
+				},
+			),
+			expectedState: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewRook(WHITE, bounds),
+					},
+				},
+			),
+			expectedError: errCannotHandleMoveType(NORMAL),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := moveApplicator.ApplyMove(tc.move, tc.state)
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedState, tc.state)
+		})
+	}
+}
+
+func TestDropMoveApplicator(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	moveApplicator := DropMoveApplicator{
+		Bounds: bounds,
+		TurnState: &TurnState{
+			Active:    BLACK,
+			TurnOrder: []Color{WHITE, BLACK},
+		},
+	}
+	testcases := []struct {
+		name          string
+		move          Move
+		state         GameboardState
+		expectedState GameboardState
+		expectedError error
+	}{
+		{
+			name: "drop places a piece of the active player",
+			move: Move{
+				Destination:   Position{Rank: 5, File: 5},
+				MoveType:      DROP,
+				DropPieceType: KNIGHT,
+			},
+			state: NewGameboardState(bounds, GameboardState{}),
+			expectedState: NewGameboardState(
+				bounds,
+				GameboardState{
+					5: {
+						5: NewKnight(BLACK),
+					},
+				},
+			),
+		},
+		{
+			name: "drop on an occupied square",
+			move: Move{
+				Destination:   Position{Rank: 5, File: 5},
+				MoveType:      DROP,
+				DropPieceType: KNIGHT,
+			},
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					5: {
+						5: NewPawn(WHITE),
+					},
+				},
+			),
+			expectedError: errDropSquareOccupied,
+		},
+		{
+			name: "cannot handle other move types",
+			move: Move{
+				Source:      Position{Rank: 5, File: 5},
+				Destination: Position{Rank: 4, File: 5},
+				MoveType:    NORMAL,
+			},
+			state:         NewGameboardState(bounds, GameboardState{}),
+			expectedError: errCannotHandleMoveType(NORMAL),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := moveApplicator.ApplyMove(tc.move, tc.state)
+			assert.Equal(t, tc.expectedError, err)
+			if tc.expectedError == nil {
+				assert.Equal(t, tc.expectedState, tc.state)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package board
+
+import (
+	"strconv"
+	"unicode"
+)
+
+// fileName returns the name of the file, e.g. "e".
+func fileName(file int) string {
+	return string('a' + rune(file))
+}
+
+// rankName returns the name of the rank, e.g. "4".
+func rankName(rank int) string {
+	return strconv.Itoa(rank + 1)
+}
+
+// PositionToSquare returns the square name of the Position, e.g. "e4".
+func PositionToSquare(position Position) string {
+	return fileName(position.File) + rankName(position.Rank)
+}
+
+// SquareToPosition returns the Position of the square name, e.g. "e4".
+func SquareToPosition(square string) (Position, error) {
+	if len(square) < 2 {
+		return Position{}, errInvalidSquare(square)
+	}
+
+	file := int(square[0] - 'a')
+	if file < 0 || file >= 26 {
+		return Position{}, errInvalidSquare(square)
+	}
+
+	rank, err := strconv.Atoi(square[1:])
+	if err != nil || rank < 1 {
+		return Position{}, errInvalidSquare(square)
+	}
+
+	return Position{Rank: rank - 1, File: file}, nil
+}
+
+// pieceTypeLetters maps uppercase piece letters to their PieceType.
+var pieceTypeLetters = map[rune]PieceType{
+	'P': PAWN,
+	'R': ROOK,
+	'B': BISHOP,
+	'N': KNIGHT,
+	'K': KING,
+	'Q': QUEEN,
+	'A': ARCHBISHOP,
+	'C': CHANCELLOR,
+}
+
+// pieceTypeLetter returns the uppercase letter of the PieceType, registered fairy PieceTypes included.
+func pieceTypeLetter(pieceType PieceType) rune {
+	for letter, letterPieceType := range pieceTypeLetters {
+		if letterPieceType == pieceType {
+			return letter
+		}
+	}
+	if piece, ok := getFairyPiece(pieceType); ok {
+		return piece.letter
+	}
+	return '?'
+}
+
+// letterPieceType returns the PieceType of the letter in either case, registered fairy PieceTypes included.
+func letterPieceType(letter rune) (PieceType, bool) {
+	letter = unicode.ToUpper(letter)
+	if pieceType, ok := pieceTypeLetters[letter]; ok {
+		return pieceType, true
+	}
+	return findFairyPieceType(func(piece fairyPiece) bool {
+		return piece.letter == letter
+	})
+}
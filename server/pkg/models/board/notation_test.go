@@ -0,0 +1,60 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSquareToPosition(t *testing.T) {
+	testCases := []struct {
+		name             string
+		square           string
+		expectedPosition Position
+		expectErr        bool
+	}{
+		{
+			name:             "First square.",
+			square:           "a1",
+			expectedPosition: Position{Rank: 0, File: 0},
+		},
+		{
+			name:             "Last square of a classic board.",
+			square:           "h8",
+			expectedPosition: Position{Rank: 7, File: 7},
+		},
+		{
+			name:             "Multi-digit rank.",
+			square:           "j10",
+			expectedPosition: Position{Rank: 9, File: 9},
+		},
+		{
+			name:      "Missing rank.",
+			square:    "e",
+			expectErr: true,
+		},
+		{
+			name:      "Invalid file.",
+			square:    "E4",
+			expectErr: true,
+		},
+		{
+			name:      "Invalid rank.",
+			square:    "e0",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := SquareToPosition(tc.square)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedPosition, position)
+			assert.Equal(t, tc.square, PositionToSquare(position))
+		})
+	}
+}
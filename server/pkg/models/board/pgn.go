@@ -0,0 +1,219 @@
+package board
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	PGNResultWhiteWins = "1-0"
+	PGNResultBlackWins = "0-1"
+	PGNResultDraw      = "1/2-1/2"
+	PGNResultOngoing   = "*"
+)
+
+// pgnLineLength is the maximum length of a movetext line.
+const pgnLineLength = 80
+
+// pgnTagPattern matches a tag pair, e.g. [Event "Casual game"].
+var pgnTagPattern = regexp.MustCompile(`^\[([A-Za-z0-9_]+)\s+"((?:[^"\\]|\\.)*)"\]$`)
+
+// pgnMoveNumberPattern matches a move number indication, e.g. "12." or "12...".
+var pgnMoveNumberPattern = regexp.MustCompile(`^[0-9]+\.+`)
+
+// PGN represents a game in Portable Game Notation.
+type PGN struct {
+	Tags   []PGNTag
+	Moves  []PGNMove
+	Result string
+}
+
+// PGNTag represents a tag pair of a PGN.
+type PGNTag struct {
+	Name  string
+	Value string
+}
+
+// PGNMove represents a move of a PGN with an optional comment.
+type PGNMove struct {
+	SAN     string
+	Comment string
+}
+
+// GetTag returns the value of the tag with the provided name.
+func (p *PGN) GetTag(name string) (string, bool) {
+	for _, tag := range p.Tags {
+		if tag.Name == name {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// String returns the PGN text, move numbers continue from the FEN tag if there is one.
+func (p *PGN) String() string {
+	builder := strings.Builder{}
+	for _, tag := range p.Tags {
+		value := strings.ReplaceAll(tag.Value, `\`, `\\`)
+		value = strings.ReplaceAll(value, `"`, `\"`)
+		builder.WriteString(fmt.Sprintf("[%s \"%s\"]\n", tag.Name, value))
+	}
+	builder.WriteString("\n")
+
+	tokens := []string{}
+	color, number := p.startingMove()
+	for i, move := range p.Moves {
+		if color == WHITE {
+			tokens = append(tokens, fmt.Sprintf("%d.", number))
+		} else if i == 0 || p.Moves[i-1].Comment != "" {
+			tokens = append(tokens, fmt.Sprintf("%d...", number))
+		}
+		tokens = append(tokens, move.SAN)
+		if move.Comment != "" {
+			tokens = append(tokens, "{"+move.Comment+"}")
+		}
+
+		if color == BLACK {
+			number += 1
+			color = WHITE
+		} else {
+			color = BLACK
+		}
+	}
+	result := p.Result
+	if result == "" {
+		result = PGNResultOngoing
+	}
+	tokens = append(tokens, result)
+
+	// Wrap the movetext between tokens.
+	lineLength := 0
+	for i, token := range tokens {
+		if i > 0 {
+			if lineLength+1+len(token) > pgnLineLength {
+				builder.WriteString("\n")
+				lineLength = 0
+			} else {
+				builder.WriteString(" ")
+				lineLength += 1
+			}
+		}
+		builder.WriteString(token)
+		lineLength += len(token)
+	}
+	builder.WriteString("\n")
+
+	return builder.String()
+}
+
+// startingMove returns the color and number of the first move from the FEN tag.
+func (p *PGN) startingMove() (Color, int) {
+	fen, ok := p.GetTag("FEN")
+	if !ok {
+		return WHITE, 1
+	}
+
+	color := Color(WHITE)
+	fields := strings.Fields(fen)
+	if len(fields) > 1 && fields[1] == "b" {
+		color = BLACK
+	}
+	number := 1
+	if len(fields) > 5 {
+		if fullmoveNumber, err := strconv.Atoi(fields[5]); err == nil && fullmoveNumber > 0 {
+			number = fullmoveNumber
+		}
+	}
+	return color, number
+}
+
+// ParsePGN parses the PGN text of a single game.
+// Variations and numeric annotation glyphs are skipped.
+func ParsePGN(pgn string) (*PGN, error) {
+	parsed := &PGN{
+		Tags:   []PGNTag{},
+		Moves:  []PGNMove{},
+		Result: PGNResultOngoing,
+	}
+
+	// Parse the tag pairs that precede the movetext.
+	lines := strings.Split(strings.ReplaceAll(pgn, "\r\n", "\n"), "\n")
+	movetextStart := len(lines)
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, "[") {
+			movetextStart = i
+			break
+		}
+		groups := pgnTagPattern.FindStringSubmatch(line)
+		if groups == nil {
+			return nil, errInvalidPGN(fmt.Sprintf("invalid tag %s", line))
+		}
+		value := strings.ReplaceAll(groups[2], `\"`, `"`)
+		value = strings.ReplaceAll(value, `\\`, `\`)
+		parsed.Tags = append(parsed.Tags, PGNTag{Name: groups[1], Value: value})
+	}
+
+	movetext := strings.Join(lines[movetextStart:], "\n")
+	variationDepth := 0
+	for i := 0; i < len(movetext); i++ {
+		switch char := movetext[i]; {
+		case char == '{':
+			end := strings.IndexByte(movetext[i:], '}')
+			if end < 0 {
+				return nil, errInvalidPGN("unterminated comment")
+			}
+			comment := strings.TrimSpace(movetext[i+1 : i+end])
+			if variationDepth == 0 && len(parsed.Moves) > 0 {
+				parsed.Moves[len(parsed.Moves)-1].Comment = comment
+			}
+			i += end
+		case char == ';':
+			end := strings.IndexByte(movetext[i:], '\n')
+			if end < 0 {
+				end = len(movetext) - i
+			}
+			i += end
+		case char == '(':
+			variationDepth += 1
+		case char == ')':
+			if variationDepth == 0 {
+				return nil, errInvalidPGN("unbalanced variation")
+			}
+			variationDepth -= 1
+		case strings.IndexByte(" \t\n", char) >= 0:
+			continue
+		default:
+			end := strings.IndexAny(movetext[i:], " \t\n{}();")
+			if end < 0 {
+				end = len(movetext) - i
+			}
+			token := movetext[i : i+end]
+			i += end - 1
+
+			if variationDepth > 0 || strings.HasPrefix(token, "$") {
+				continue
+			}
+			token = pgnMoveNumberPattern.ReplaceAllString(token, "")
+			switch token {
+			case "":
+				continue
+			case PGNResultWhiteWins, PGNResultBlackWins, PGNResultDraw, PGNResultOngoing:
+				parsed.Result = token
+			default:
+				parsed.Moves = append(parsed.Moves, PGNMove{SAN: token})
+			}
+		}
+	}
+
+	if variationDepth != 0 {
+		return nil, errInvalidPGN("unbalanced variation")
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,169 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePGN(t *testing.T) {
+	testCases := []struct {
+		name        string
+		pgn         string
+		expectedPGN *PGN
+	}{
+		{
+			name: "Tags, comments and result.",
+			pgn: `[Event "Casual \"blitz\" game"]
+[White "alice"]
+
+1. e4 {[%clk 0:04:59]} 1... e5 {[%clk 0:04:58]} 2. Nf3 Nc6 1-0
+`,
+			expectedPGN: &PGN{
+				Tags: []PGNTag{
+					{Name: "Event", Value: `Casual "blitz" game`},
+					{Name: "White", Value: "alice"},
+				},
+				Moves: []PGNMove{
+					{SAN: "e4", Comment: "[%clk 0:04:59]"},
+					{SAN: "e5", Comment: "[%clk 0:04:58]"},
+					{SAN: "Nf3"},
+					{SAN: "Nc6"},
+				},
+				Result: PGNResultWhiteWins,
+			},
+		},
+		{
+			name: "Variations, glyphs and rest of line comments are skipped.",
+			pgn:  "1.e4 $1 (1.d4 {queen's pawn} d5) e5 ; open game\n2.Bc4 *",
+			expectedPGN: &PGN{
+				Tags: []PGNTag{},
+				Moves: []PGNMove{
+					{SAN: "e4"},
+					{SAN: "e5"},
+					{SAN: "Bc4"},
+				},
+				Result: PGNResultOngoing,
+			},
+		},
+		{
+			name: "Missing result.",
+			pgn:  "1. d4 d5",
+			expectedPGN: &PGN{
+				Tags: []PGNTag{},
+				Moves: []PGNMove{
+					{SAN: "d4"},
+					{SAN: "d5"},
+				},
+				Result: PGNResultOngoing,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pgn, err := ParsePGN(tc.pgn)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedPGN, pgn)
+		})
+	}
+}
+
+func TestParsePGNInvalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		pgn  string
+	}{
+		{
+			name: "Invalid tag.",
+			pgn:  "[Event Casual]\n\n1. e4 *",
+		},
+		{
+			name: "Unterminated comment.",
+			pgn:  "1. e4 {comment *",
+		},
+		{
+			name: "Unbalanced variation.",
+			pgn:  "1. e4 (1. d4 *",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pgn, err := ParsePGN(tc.pgn)
+			assert.Nil(t, pgn)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestPGNString(t *testing.T) {
+	testCases := []struct {
+		name        string
+		pgn         PGN
+		expectedPGN string
+	}{
+		{
+			name: "Moves with comments.",
+			pgn: PGN{
+				Tags: []PGNTag{
+					{Name: "White", Value: `a "quoted" name`},
+				},
+				Moves: []PGNMove{
+					{SAN: "e4", Comment: "[%clk 0:04:59]"},
+					{SAN: "e5", Comment: "[%clk 0:04:58]"},
+					{SAN: "Nf3"},
+					{SAN: "Nc6"},
+				},
+				Result: PGNResultDraw,
+			},
+			expectedPGN: `[White "a \"quoted\" name"]
+
+1. e4 {[%clk 0:04:59]} 1... e5 {[%clk 0:04:58]} 2. Nf3 Nc6 1/2-1/2
+`,
+		},
+		{
+			name: "Black to move from a FEN.",
+			pgn: PGN{
+				Tags: []PGNTag{
+					{Name: "FEN", Value: "4k3/8/8/8/4P3/8/8/4K3 b - e3 0 12"},
+				},
+				Moves: []PGNMove{
+					{SAN: "Kd7"},
+					{SAN: "Kd2"},
+				},
+			},
+			expectedPGN: `[FEN "4k3/8/8/8/4P3/8/8/4K3 b - e3 0 12"]
+
+12... Kd7 13. Kd2 *
+`,
+		},
+		{
+			name: "Long movetext is wrapped.",
+			pgn: PGN{
+				Tags: []PGNTag{},
+				Moves: []PGNMove{
+					{SAN: "Nf3"}, {SAN: "Nf6"}, {SAN: "Ng1"}, {SAN: "Ng8"},
+					{SAN: "Nf3"}, {SAN: "Nf6"}, {SAN: "Ng1"}, {SAN: "Ng8"},
+					{SAN: "Nf3"}, {SAN: "Nf6"}, {SAN: "Ng1"}, {SAN: "Ng8"},
+					{SAN: "Nf3"}, {SAN: "Nf6"}, {SAN: "Ng1"}, {SAN: "Ng8"},
+				},
+				Result: PGNResultOngoing,
+			},
+			expectedPGN: `
+1. Nf3 Nf6 2. Ng1 Ng8 3. Nf3 Nf6 4. Ng1 Ng8 5. Nf3 Nf6 6. Ng1 Ng8 7. Nf3 Nf6 8.
+Ng1 Ng8 *
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedPGN, tc.pgn.String())
+
+			parsed, err := ParsePGN(tc.expectedPGN)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.pgn.Moves, parsed.Moves)
+		})
+	}
+}
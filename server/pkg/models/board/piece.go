@@ -9,6 +9,8 @@ type Piece struct {
 	Color          Color     `json:"color"`
 	PieceType      PieceType `json:"piece_type"`
 	AvailableMoves MoveMap   `json:"available_moves,omitempty"`
+	// Promoted is true if the Piece is a promoted pawn, it returns to a reserve as a pawn.
+	Promoted       bool `json:"promoted,omitempty"`
 	moveGenerators []moveGenerator
 }
 
@@ -52,15 +54,35 @@ func NewPiece(color Color, pieceType PieceType, moveGenerators ...moveGenerator)
 	}
 }
 
+// NewPieceOfType creates a Piece of the provided PieceType, registered fairy PieceTypes included.
+func NewPieceOfType(color Color, pieceType PieceType, bounds Bounds) (*Piece, error) {
+	switch pieceType {
+	case PAWN:
+		return NewPawn(color), nil
+	case ROOK:
+		return NewRook(color, bounds), nil
+	case BISHOP:
+		return NewBishop(color, bounds), nil
+	case KNIGHT:
+		return NewKnight(color), nil
+	case KING:
+		return NewKing(color), nil
+	case QUEEN:
+		return NewQueen(color, bounds), nil
+	case ARCHBISHOP:
+		return NewArchbishop(color, bounds), nil
+	case CHANCELLOR:
+		return NewChancellor(color, bounds), nil
+	}
+	if piece, ok := getFairyPiece(pieceType); ok {
+		return NewBetzaPiece(color, pieceType, piece.movement, bounds)
+	}
+	return nil, errInvalidPieceType(pieceType)
+}
+
 // NewPawn creates a Piece with type PAWN.
 func NewPawn(color Color) *Piece {
-	var direction Direction
-	switch color {
-	case WHITE:
-		direction = North
-	case BLACK:
-		direction = South
-	}
+	direction := pawnDirection(color)
 
 	return NewPiece(
 		color,
@@ -68,9 +90,52 @@ func NewPawn(color Color) *Piece {
 		&SingleNormalMoveGenerator{direction: direction},
 		&DoublePushMoveGenerator{color: color},
 		&SingleDiagonalCaputureMoveGenerator{color: color},
+		&EnPassantMoveGenerator{color: color},
+		&PromotionMoveGenerator{direction: direction},
+		&PromotionCaptureMoveGenerator{color: color},
 	)
 }
 
+// pawnDirection returns the Direction the pawns of the Color move in,
+// on four-player boards blue and green pawns move across the files.
+func pawnDirection(color Color) Direction {
+	switch color {
+	case BLACK, YELLOW:
+		return South
+	case BLUE:
+		return East
+	case GREEN:
+		return West
+	default:
+		return North
+	}
+}
+
+// pawnCaptureDirections returns the two forward diagonal Directions the pawns of the Color capture in.
+func pawnCaptureDirections(color Color) []Direction {
+	switch pawnDirection(color) {
+	case South:
+		return []Direction{SouthWest, SouthEast}
+	case East:
+		return []Direction{SouthEast, NorthEast}
+	case West:
+		return []Direction{SouthWest, NorthWest}
+	default:
+		return []Direction{NorthWest, NorthEast}
+	}
+}
+
+// pawnCoordinates returns the rank and file of the Position as seen by the pawns of the Color,
+// pawns moving east or west advance along the files instead of the ranks.
+func pawnCoordinates(color Color, position Position) (int, int) {
+	switch pawnDirection(color) {
+	case East, West:
+		return position.File, position.Rank
+	default:
+		return position.Rank, position.File
+	}
+}
+
 // NewKnight creates a Piece with type KNIGHT.
 func NewKnight(color Color) *Piece {
 	return NewPiece(
@@ -114,19 +179,38 @@ func NewQueen(color Color, bounds Bounds) *Piece {
 	)
 }
 
-// NewKing creates a Piece with type KING.
-func NewKing(color Color) *Piece {
+// NewArchbishop creates a Piece with type ARCHBISHOP,
+// it moves as a bishop or a knight.
+func NewArchbishop(color Color, bounds Bounds) *Piece {
+	return NewPiece(
+		color,
+		ARCHBISHOP,
+		NewBishop(color, bounds),
+		NewKnight(color),
+	)
+}
+
+// NewChancellor creates a Piece with type CHANCELLOR,
+// it moves as a rook or a knight.
+func NewChancellor(color Color, bounds Bounds) *Piece {
 	return NewPiece(
 		color,
-		KING,
-		&SingleNormalMoveGenerator{direction: North},
-		&SingleNormalMoveGenerator{direction: NorthEast},
-		&SingleNormalMoveGenerator{direction: East},
-		&SingleNormalMoveGenerator{direction: SouthEast},
-		&SingleNormalMoveGenerator{direction: South},
-		&SingleNormalMoveGenerator{direction: SouthWest},
-		&SingleNormalMoveGenerator{direction: West},
-		&SingleNormalMoveGenerator{direction: NorthWest},
-		&CastleMoveGenerator{color: color},
+		CHANCELLOR,
+		NewRook(color, bounds),
+		NewKnight(color),
 	)
 }
+
+// NewKing creates a Piece with type KING,
+// it moves and captures one square in any direction.
+func NewKing(color Color) *Piece {
+	moveGenerators := []moveGenerator{}
+	for _, direction := range allDirections {
+		moveGenerators = append(
+			moveGenerators,
+			&SingleNormalMoveGenerator{direction: direction},
+			&SingleCaptureMoveGenerator{direction: direction},
+		)
+	}
+	return NewPiece(color, KING, moveGenerators...)
+}
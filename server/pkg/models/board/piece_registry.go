@@ -0,0 +1,90 @@
+package board
+
+import (
+	"strings"
+	"sync"
+)
+
+// fairyPiece is a fairy PieceType registered with its name, letter and movement in Betza notation.
+type fairyPiece struct {
+	name     string
+	letter   rune
+	movement string
+}
+
+// pieceRegistry holds the fairy PieceTypes, they're numbered after the built-in PieceTypes.
+var pieceRegistry = struct {
+	pieces map[PieceType]fairyPiece
+	mux    *sync.RWMutex
+}{
+	pieces: map[PieceType]fairyPiece{},
+	mux:    &sync.RWMutex{},
+}
+
+// RegisterPieceType registers a fairy PieceType moving as described by the Betza notation and returns it.
+// The letter represents the piece in FEN and SAN, uppercase for white and lowercase for black.
+// Registering the same piece again returns its PieceType, reusing its name or letter is an error.
+func RegisterPieceType(name string, letter rune, movement string) (PieceType, error) {
+	if name == "" || name != strings.ToLower(name) {
+		return NONE, errInvalidPieceRegistration(name, "the name must be lowercase")
+	}
+	if letter < 'A' || letter > 'Z' {
+		return NONE, errInvalidPieceRegistration(name, "the letter must be uppercase")
+	}
+	if _, err := NewBetzaPiece(WHITE, NONE, movement, Bounds{}); err != nil {
+		return NONE, err
+	}
+	for _, pieceType := range builtinPieceTypes {
+		if pieceType.String() == name {
+			return NONE, errInvalidPieceRegistration(name, "the name is used by a built-in piece")
+		}
+	}
+	if _, ok := pieceTypeLetters[letter]; ok {
+		return NONE, errInvalidPieceRegistration(name, "the letter is used by a built-in piece")
+	}
+
+	pieceRegistry.mux.Lock()
+	defer pieceRegistry.mux.Unlock()
+
+	for pieceType, piece := range pieceRegistry.pieces {
+		if piece.name == name && piece.letter == letter && piece.movement == movement {
+			return pieceType, nil
+		}
+		if piece.name == name {
+			return NONE, errInvalidPieceRegistration(name, "the name is already registered")
+		}
+		if piece.letter == letter {
+			return NONE, errInvalidPieceRegistration(name, "the letter is used by "+piece.name)
+		}
+	}
+
+	pieceType := builtinPieceTypes[len(builtinPieceTypes)-1] + PieceType(len(pieceRegistry.pieces)+1)
+	pieceRegistry.pieces[pieceType] = fairyPiece{
+		name:     name,
+		letter:   letter,
+		movement: movement,
+	}
+	return pieceType, nil
+}
+
+// getFairyPiece returns the registered fairy piece of the PieceType.
+func getFairyPiece(pieceType PieceType) (fairyPiece, bool) {
+	pieceRegistry.mux.RLock()
+	defer pieceRegistry.mux.RUnlock()
+
+	piece, ok := pieceRegistry.pieces[pieceType]
+	return piece, ok
+}
+
+// findFairyPieceType returns the registered fairy PieceType whose piece satisfies the predicate.
+func findFairyPieceType(predicate func(piece fairyPiece) bool) (PieceType, bool) {
+	pieceRegistry.mux.RLock()
+	defer pieceRegistry.mux.RUnlock()
+
+	for pieceType, piece := range pieceRegistry.pieces {
+		if predicate(piece) {
+			return pieceType, true
+		}
+	}
+	return NONE, false
+}
@@ -0,0 +1,122 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterPieceType(t *testing.T) {
+	amazon, err := RegisterPieceType("amazon", 'M', "QN")
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		name              string
+		pieceName         string
+		letter            rune
+		movement          string
+		expectedPieceType PieceType
+		expectErr         bool
+	}{
+		{
+			name:              "Registering the same piece again.",
+			pieceName:         "amazon",
+			letter:            'M',
+			movement:          "QN",
+			expectedPieceType: amazon,
+		},
+		{
+			name:      "Registered name with another movement.",
+			pieceName: "amazon",
+			letter:    'M',
+			movement:  "RN",
+			expectErr: true,
+		},
+		{
+			name:      "Registered letter.",
+			pieceName: "mammoth",
+			letter:    'M',
+			movement:  "K",
+			expectErr: true,
+		},
+		{
+			name:      "Built-in name.",
+			pieceName: "queen",
+			letter:    'X',
+			movement:  "Q",
+			expectErr: true,
+		},
+		{
+			name:      "Built-in letter.",
+			pieceName: "dragon",
+			letter:    'Q',
+			movement:  "QN",
+			expectErr: true,
+		},
+		{
+			name:      "Lowercase letter.",
+			pieceName: "dragon",
+			letter:    'd',
+			movement:  "QN",
+			expectErr: true,
+		},
+		{
+			name:      "Uppercase name.",
+			pieceName: "Dragon",
+			letter:    'D',
+			movement:  "QN",
+			expectErr: true,
+		},
+		{
+			name:      "Invalid movement.",
+			pieceName: "dragon",
+			letter:    'D',
+			movement:  "Dz",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pieceType, err := RegisterPieceType(tc.pieceName, tc.letter, tc.movement)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedPieceType, pieceType)
+		})
+	}
+}
+
+func TestRegisteredPieceType(t *testing.T) {
+	amazon, err := RegisterPieceType("amazon", 'M', "QN")
+	assert.Nil(t, err)
+
+	// The name and letter of the registered piece type are used like those of built-in pieces.
+	assert.Equal(t, "amazon", amazon.String())
+	parsed, err := ParsePieceType("amazon")
+	assert.Nil(t, err)
+	assert.Equal(t, amazon, parsed)
+
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	position, err := ParseFEN("4k3/8/8/8/8/8/8/3MK3 w - - 0 1", bounds)
+	assert.Nil(t, err)
+	board := Build(WithBounds(bounds), WithFEN(position))
+	assert.Equal(t, amazon, board.GameboardState[0][3].PieceType)
+	assert.Equal(t, "4k3/8/8/8/8/8/8/3MK3 w - - 0 1", board.FEN())
+
+	// The registered piece moves as described by its movement.
+	move, err := board.ParseSAN("Mc3")
+	assert.Nil(t, err)
+	assert.Equal(t, Move{Source: Position{Rank: 0, File: 3}, Destination: Position{Rank: 2, File: 2}, MoveType: JUMP}, move)
+	san, err := board.MoveToSAN(move)
+	assert.Nil(t, err)
+	assert.Equal(t, "Mc3", san)
+
+	// Letters that aren't registered are rejected.
+	_, err = board.ParseSAN("Xe4")
+	assert.NotNil(t, err)
+	_, err = ParseFEN("4k3/8/8/8/8/8/8/3XK3 w - - 0 1", bounds)
+	assert.NotNil(t, err)
+}
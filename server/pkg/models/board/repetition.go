@@ -0,0 +1,97 @@
+package board
+
+import "strings"
+
+const (
+	// threefoldRepetitions is the number of repetitions a draw can be claimed at.
+	threefoldRepetitions = 3
+	// fivefoldRepetitions is the number of repetitions the game is drawn at.
+	fivefoldRepetitions = 5
+)
+
+// RepetitionState is used to count how many times each position has occurred.
+type RepetitionState struct {
+	// PositionCounts maps position keys to the number of times they occurred.
+	PositionCounts map[string]int
+}
+
+// NewDefaultRepetitionState creates a new RepetitionState with no positions.
+func NewDefaultRepetitionState() *RepetitionState {
+	return &RepetitionState{
+		PositionCounts: map[string]int{},
+	}
+}
+
+// RecordPosition counts an occurrence of the position key.
+func (r *RepetitionState) RecordPosition(key string) {
+	r.PositionCounts[key] += 1
+}
+
+// ClearPositions forgets all the positions, used after irreversible moves.
+func (r *RepetitionState) ClearPositions() {
+	r.PositionCounts = map[string]int{}
+}
+
+// GetRepetitions returns the number of times the position key has occurred.
+func (r *RepetitionState) GetRepetitions(key string) int {
+	return r.PositionCounts[key]
+}
+
+// positionKey returns the key of the Board's position,
+// the piece placement with the reserves, side to move, castling rights and en passant square.
+// The available moves must be up to date as the en passant square only counts when it can be captured on.
+func (b *Board) positionKey() string {
+	placement := b.fenPlacement()
+	if b.ReserveState != nil {
+		placement += b.fenReserves()
+	}
+	enPassantTarget := "-"
+	if b.hasEnPassantMove() {
+		enPassantTarget = b.fenEnPassantTarget()
+	}
+
+	return strings.Join(
+		[]string{
+			placement,
+			b.fenActiveColor(),
+			b.fenCastlingRights(),
+			enPassantTarget,
+		},
+		" ",
+	)
+}
+
+// hasEnPassantMove returns true if any piece of the active player can capture en passant.
+func (b *Board) hasEnPassantMove() bool {
+	hasEnPassant := false
+	b.forEachPiece(
+		b.GameboardState,
+		func(position Position, piece *Piece) {
+			if piece != nil && piece.Color == b.GetActivePlayer() && len(piece.AvailableMoves[EN_PASSANT]) > 0 {
+				hasEnPassant = true
+			}
+		},
+	)
+	return hasEnPassant
+}
+
+// recordPosition counts an occurrence of the Board's current position,
+// positions before a capture or pawn move can't occur again so they are forgotten.
+// With reserves captured pieces can be dropped back, so the positions are kept.
+func (b *Board) recordPosition() {
+	if b.RepetitionState == nil {
+		return
+	}
+	if b.HalfmoveClock == 0 && b.ReserveState == nil {
+		b.ClearPositions()
+	}
+	b.RecordPosition(b.positionKey())
+}
+
+// getRepetitions returns the number of times the Board's current position has occurred.
+func (b *Board) getRepetitions() int {
+	if b.RepetitionState == nil {
+		return 0
+	}
+	return b.GetRepetitions(b.positionKey())
+}
@@ -0,0 +1,145 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepetitionState(t *testing.T) {
+	repetitionState := NewDefaultRepetitionState()
+	repetitionState.RecordPosition("a")
+	repetitionState.RecordPosition("b")
+	repetitionState.RecordPosition("a")
+
+	assert.Equal(t, 2, repetitionState.GetRepetitions("a"))
+	assert.Equal(t, 1, repetitionState.GetRepetitions("b"))
+	assert.Equal(t, 0, repetitionState.GetRepetitions("c"))
+
+	repetitionState.ClearPositions()
+	assert.Equal(t, 0, repetitionState.GetRepetitions("a"))
+}
+
+func TestRepetitions(t *testing.T) {
+	knightShuffle := []Move{
+		{Source: Position{Rank: 0, File: 6}, Destination: Position{Rank: 2, File: 5}, MoveType: JUMP},
+		{Source: Position{Rank: 7, File: 6}, Destination: Position{Rank: 5, File: 5}, MoveType: JUMP},
+		{Source: Position{Rank: 2, File: 5}, Destination: Position{Rank: 0, File: 6}, MoveType: JUMP},
+		{Source: Position{Rank: 5, File: 5}, Destination: Position{Rank: 7, File: 6}, MoveType: JUMP},
+	}
+	testCases := []struct {
+		name                 string
+		shuffles             int
+		extraMoves           []Move
+		expectedRepetitions  int
+		expectClaimErr       bool
+		expectedEndStateType EndStateType
+	}{
+		{
+			name:                 "Twofold repetition can't be claimed.",
+			shuffles:             1,
+			expectedRepetitions:  2,
+			expectClaimErr:       true,
+			expectedEndStateType: EndStateNone,
+		},
+		{
+			name:                 "Threefold repetition can be claimed.",
+			shuffles:             2,
+			expectedRepetitions:  3,
+			expectedEndStateType: EndStateThreefoldRepetition,
+		},
+		{
+			name:     "Pawn move resets the repetitions.",
+			shuffles: 2,
+			extraMoves: []Move{
+				{Source: Position{Rank: 1, File: 0}, Destination: Position{Rank: 2, File: 0}, MoveType: NORMAL},
+			},
+			expectedRepetitions:  1,
+			expectClaimErr:       true,
+			expectedEndStateType: EndStateNone,
+		},
+		{
+			name:                 "Fivefold repetition ends the game.",
+			shuffles:             4,
+			expectedRepetitions:  5,
+			expectClaimErr:       true,
+			expectedEndStateType: EndStateFivefoldRepetition,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(startingFEN, Bounds{RankCount: 8, FileCount: 8})
+			assert.Nil(t, err)
+			board := Build(WithFEN(position))
+			for i := 0; i < tc.shuffles; i++ {
+				for _, move := range knightShuffle {
+					assert.Nil(t, board.HandleMove(move))
+				}
+			}
+			for _, move := range tc.extraMoves {
+				assert.Nil(t, board.HandleMove(move))
+			}
+			assert.Equal(t, tc.expectedRepetitions, board.getRepetitions())
+
+			err = board.ClaimDraw()
+			if tc.expectClaimErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tc.expectedEndStateType, board.GetGameEndState().EndStateType)
+		})
+	}
+}
+
+func TestRepetitionsEnPassant(t *testing.T) {
+	doublePush := Move{Source: Position{Rank: 1, File: 4}, Destination: Position{Rank: 3, File: 4}, MoveType: PAWN_DOUBLE_PUSH}
+	knightShuffle := []Move{
+		{Source: Position{Rank: 7, File: 6}, Destination: Position{Rank: 5, File: 5}, MoveType: JUMP},
+		{Source: Position{Rank: 0, File: 6}, Destination: Position{Rank: 2, File: 5}, MoveType: JUMP},
+		{Source: Position{Rank: 5, File: 5}, Destination: Position{Rank: 7, File: 6}, MoveType: JUMP},
+		{Source: Position{Rank: 2, File: 5}, Destination: Position{Rank: 0, File: 6}, MoveType: JUMP},
+	}
+	testCases := []struct {
+		name                string
+		fen                 string
+		expectedRepetitions int
+	}{
+		{
+			name:                "En passant square that can't be captured on is ignored.",
+			fen:                 startingFEN,
+			expectedRepetitions: 3,
+		},
+		{
+			name:                "En passant square that can be captured on is counted.",
+			fen:                 "rnbqkbnr/ppp1pppp/8/8/3p4/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+			expectedRepetitions: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, Bounds{RankCount: 8, FileCount: 8})
+			assert.Nil(t, err)
+			board := Build(WithFEN(position))
+			assert.Nil(t, board.HandleMove(doublePush))
+			for i := 0; i < 2; i++ {
+				for _, move := range knightShuffle {
+					assert.Nil(t, board.HandleMove(move))
+				}
+			}
+			assert.Equal(t, tc.expectedRepetitions, board.getRepetitions())
+		})
+	}
+}
+
+func TestRepetitionsReserves(t *testing.T) {
+	board := newReserveBoard(t, "4k3/8/8/3p4/4P3/8/8/4K3[] w - - 0 1")
+	key := board.positionKey()
+
+	// The captured pawn can be dropped back, the position before the capture is kept.
+	capture := Move{Source: Position{Rank: 3, File: 4}, Destination: Position{Rank: 4, File: 3}, MoveType: CAPTURE}
+	assert.Nil(t, board.HandleMove(capture))
+	assert.Equal(t, 1, board.GetRepetitions(key))
+}
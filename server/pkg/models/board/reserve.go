@@ -0,0 +1,201 @@
+package board
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// reservePieceTypes lists the PieceTypes of a reserve in the order they are written in a FEN.
+var reservePieceTypes = []PieceType{KING, QUEEN, CHANCELLOR, ARCHBISHOP, ROOK, BISHOP, KNIGHT, PAWN}
+
+// ReserveState is used to hold the captured pieces each Color can drop back onto the board.
+type ReserveState struct {
+	// Reserves maps each Color to the number of pieces of each PieceType in its reserve.
+	Reserves map[Color]map[PieceType]int
+	// AvailableDrops maps the PieceTypes the active player can drop to the squares they can be dropped on.
+	AvailableDrops map[PieceType][]Position
+}
+
+// NewDefaultReserveState creates a new ReserveState with empty reserves.
+func NewDefaultReserveState() *ReserveState {
+	return &ReserveState{
+		Reserves: map[Color]map[PieceType]int{
+			WHITE: {},
+			BLACK: {},
+		},
+		AvailableDrops: map[PieceType][]Position{},
+	}
+}
+
+// AddToReserve adds a piece of the PieceType to the Color's reserve.
+func (r *ReserveState) AddToReserve(color Color, pieceType PieceType) {
+	if _, ok := r.Reserves[color]; !ok {
+		r.Reserves[color] = map[PieceType]int{}
+	}
+	r.Reserves[color][pieceType] += 1
+}
+
+// RemoveFromReserve removes a piece of the PieceType from the Color's reserve.
+func (r *ReserveState) RemoveFromReserve(color Color, pieceType PieceType) {
+	if r.Reserves[color][pieceType] <= 0 {
+		return
+	}
+	r.Reserves[color][pieceType] -= 1
+	if r.Reserves[color][pieceType] == 0 {
+		delete(r.Reserves[color], pieceType)
+	}
+}
+
+// IsAvailableDrop returns true if the Move is a drop available to the active player.
+func (r *ReserveState) IsAvailableDrop(move Move) bool {
+	if move.MoveType != DROP {
+		return false
+	}
+	for _, destination := range r.AvailableDrops[move.DropPieceType] {
+		if destination == move.Destination {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAvailableDrop returns true if the active player can drop any piece.
+func (r *ReserveState) HasAvailableDrop() bool {
+	for _, destinations := range r.AvailableDrops {
+		if len(destinations) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetReserves returns the number of pieces of each PieceType in each Color's reserve,
+// nil if the Board doesn't keep reserves.
+func (b *Board) GetReserves() map[Color]map[PieceType]int {
+	if b.ReserveState == nil {
+		return nil
+	}
+	reserves := map[Color]map[PieceType]int{}
+	for color, reserve := range b.Reserves {
+		reserves[color] = map[PieceType]int{}
+		for pieceType, count := range reserve {
+			reserves[color][pieceType] = count
+		}
+	}
+	return reserves
+}
+
+// updateReserve adds the piece captured by the Color's Move to its reserve,
+// promoted pieces are added as pawns and the piece promoted by the Move is marked as promoted.
+func (b *Board) updateReserve(color Color, move Move, capturedPiece *Piece) {
+	if capturedPiece != nil {
+		pieceType := capturedPiece.PieceType
+		if capturedPiece.Promoted {
+			pieceType = PAWN
+		}
+		b.AddToReserve(color, pieceType)
+	}
+
+	if move.MoveType == PROMOTION || move.MoveType == PROMOTION_CAPTURE {
+		if promotedPiece := b.GameboardState[move.Destination.Rank][move.Destination.File]; promotedPiece != nil {
+			promotedPiece.Promoted = true
+		}
+	}
+}
+
+// generateLegalDrops returns the squares each PieceType in the Color's reserve can be legally dropped on.
+func (b *Board) generateLegalDrops(color Color, state GameboardState) map[PieceType][]Position {
+	drops := map[PieceType][]Position{}
+	// A drop only adds a piece of the dropping Color, whether it's legal doesn't depend on its PieceType.
+	legalStates := map[Position]bool{}
+	for pieceType, count := range b.Reserves[color] {
+		if count <= 0 {
+			continue
+		}
+		drops[pieceType] = []Position{}
+		for rank := 0; rank < b.RankCount; rank++ {
+			for file := 0; file < b.FileCount; file++ {
+				move := Move{
+					Destination:   Position{Rank: rank, File: file},
+					MoveType:      DROP,
+					DropPieceType: pieceType,
+				}
+				if !b.IsLegalMove(move, state) {
+					continue
+				}
+				isLegalState, ok := legalStates[move.Destination]
+				if !ok {
+					isLegalState = b.legalGameboardStatePredicate(NewPiece(color, pieceType), move, state)
+					legalStates[move.Destination] = isLegalState
+				}
+				if isLegalState {
+					drops[pieceType] = append(drops[pieceType], move.Destination)
+				}
+			}
+		}
+	}
+	return drops
+}
+
+// hasDropEscape returns true if the Color can drop a piece from its reserve that gets it out of check.
+func (b *Board) hasDropEscape(color Color, state GameboardState) bool {
+	for pieceType, count := range b.Reserves[color] {
+		if count <= 0 {
+			continue
+		}
+		for rank := 0; rank < b.RankCount; rank++ {
+			for file := 0; file < b.FileCount; file++ {
+				move := Move{
+					Destination:   Position{Rank: rank, File: file},
+					MoveType:      DROP,
+					DropPieceType: pieceType,
+				}
+				if !b.IsLegalMove(move, state) {
+					continue
+				}
+				escapeState := CopyGameboardState(state)
+				escapeState[rank][file] = NewPiece(color, pieceType)
+				if !isColorInCheck(color, escapeState, b.generateLegalFilteredMoves(escapeState)) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// parseFENReserves parses the reserves written in brackets after the piece placement of a FEN string,
+// e.g. [Qp] for a queen in white's reserve and a pawn in black's.
+func parseFENReserves(field string) (map[Color]map[PieceType]int, error) {
+	reserves := map[Color]map[PieceType]int{
+		WHITE: {},
+		BLACK: {},
+	}
+	for _, symbol := range field {
+		pieceType, ok := letterPieceType(symbol)
+		if !ok {
+			return nil, fmt.Errorf("invalid reserve piece %c", symbol)
+		}
+		color := Color(BLACK)
+		if unicode.IsUpper(symbol) {
+			color = WHITE
+		}
+		reserves[color][pieceType] += 1
+	}
+	return reserves, nil
+}
+
+// fenReserves returns the reserves of the Board's FEN in brackets, white's pieces first.
+func (b *Board) fenReserves() string {
+	builder := strings.Builder{}
+	builder.WriteString("[")
+	for _, color := range []Color{WHITE, BLACK} {
+		for _, pieceType := range reservePieceTypes {
+			symbol := fenSymbol(&Piece{Color: color, PieceType: pieceType})
+			builder.WriteString(strings.Repeat(string(symbol), b.Reserves[color][pieceType]))
+		}
+	}
+	builder.WriteString("]")
+	return builder.String()
+}
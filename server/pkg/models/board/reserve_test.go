@@ -0,0 +1,307 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newReserveBoard builds a Board with reserves and drops from the FEN.
+func newReserveBoard(t *testing.T, fen string) *Board {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	position, err := ParseFEN(fen, bounds)
+	assert.Nil(t, err)
+
+	turnState := &TurnState{
+		Active:    WHITE,
+		TurnOrder: []Color{BLACK, WHITE},
+	}
+	promotionRanks := NewDefaultPromotionRanks(bounds)
+	return Build(
+		WithCastlingState(nil),
+		WithReserveState(NewDefaultReserveState()),
+		WithMoveApplicator(
+			NewMoveApplicator(
+				&SinglePieceMoveApplicator{},
+				&PromotionMoveApplicator{Bounds: bounds},
+				&DropMoveApplicator{Bounds: bounds, TurnState: turnState},
+			),
+		),
+		WithMoveFilter(
+			NewMoveFilter(
+				&FilterOutOfBounds{Bounds: bounds},
+				&FilterPieceCollision{},
+				&FilterFriendlyCapture{},
+				&FilterIllegalPromotion{PromotionRanks: promotionRanks},
+				&FilterIllegalPromotionCapture{PromotionRanks: promotionRanks},
+				&FilterMissingPromotion{PromotionRanks: promotionRanks},
+				&FilterIllegalDrop{Bounds: bounds},
+			),
+		),
+		WithIllegalStateFilter(
+			NewIllegalStateFilter(
+				&IllegalCheckStateFilter{TurnState: turnState},
+			),
+		),
+		WithTurnState(turnState),
+		WithFEN(position),
+	)
+}
+
+func TestReserveState(t *testing.T) {
+	reserveState := NewDefaultReserveState()
+	reserveState.AddToReserve(WHITE, KNIGHT)
+	reserveState.AddToReserve(WHITE, KNIGHT)
+	reserveState.AddToReserve(BLACK, PAWN)
+	reserveState.RemoveFromReserve(WHITE, KNIGHT)
+	reserveState.RemoveFromReserve(BLACK, PAWN)
+	reserveState.RemoveFromReserve(BLACK, QUEEN)
+
+	assert.Equal(
+		t,
+		map[Color]map[PieceType]int{
+			WHITE: {KNIGHT: 1},
+			BLACK: {},
+		},
+		reserveState.Reserves,
+	)
+}
+
+func TestReserves(t *testing.T) {
+	testCases := []struct {
+		name             string
+		fen              string
+		move             Move
+		expectedReserves map[Color]map[PieceType]int
+		expectedFEN      string
+	}{
+		{
+			name: "Captured piece joins the capturer's reserve.",
+			fen:  "4k3/8/8/3p4/4P3/8/8/4K3[] w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 3, File: 4},
+				Destination: Position{Rank: 4, File: 3},
+				MoveType:    CAPTURE,
+			},
+			expectedReserves: map[Color]map[PieceType]int{
+				WHITE: {PAWN: 1},
+				BLACK: {},
+			},
+			expectedFEN: "4k3/8/8/3P4/8/8/8/4K3[P] b - - 0 1",
+		},
+		{
+			name: "Captured promoted piece joins the reserve as a pawn.",
+			fen:  "4k3/8/8/3q4/8/8/8/3Q~K3[] b - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 4, File: 3},
+				Destination: Position{Rank: 0, File: 3},
+				MoveType:    CAPTURE,
+			},
+			expectedReserves: map[Color]map[PieceType]int{
+				WHITE: {},
+				BLACK: {PAWN: 1},
+			},
+			expectedFEN: "4k3/8/8/8/8/8/8/3qK3[p] w - - 0 2",
+		},
+		{
+			name: "Promotion marks the piece as promoted.",
+			fen:  "4k3/P7/8/8/8/8/8/4K3[] w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 6, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    PROMOTION,
+			},
+			expectedReserves: map[Color]map[PieceType]int{
+				WHITE: {},
+				BLACK: {},
+			},
+			expectedFEN: "Q~3k3/8/8/8/8/8/8/4K3[] b - - 0 1",
+		},
+		{
+			name: "Drop takes the piece from the reserve.",
+			fen:  "4k3/8/8/8/8/8/8/4K3[NNp] w - - 0 1",
+			move: Move{
+				Destination:   Position{Rank: 2, File: 5},
+				MoveType:      DROP,
+				DropPieceType: KNIGHT,
+			},
+			expectedReserves: map[Color]map[PieceType]int{
+				WHITE: {KNIGHT: 1},
+				BLACK: {PAWN: 1},
+			},
+			expectedFEN: "4k3/8/8/8/8/5N2/8/4K3[Np] b - - 1 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			board := newReserveBoard(t, tc.fen)
+
+			assert.Nil(t, board.HandleMove(tc.move))
+			assert.Equal(t, tc.expectedReserves, board.GetReserves())
+			assert.Equal(t, tc.expectedFEN, board.FEN())
+		})
+	}
+}
+
+func TestReservesNotKept(t *testing.T) {
+	board := Build()
+	assert.Nil(t, board.GetReserves())
+	assert.NotNil(t, board.HandleMove(Move{
+		Destination:   Position{Rank: 3, File: 3},
+		MoveType:      DROP,
+		DropPieceType: KNIGHT,
+	}))
+}
+
+func TestAvailableDrops(t *testing.T) {
+	testCases := []struct {
+		name              string
+		fen               string
+		move              Move
+		expectedAvailable bool
+	}{
+		{
+			name: "Drop on an empty square.",
+			fen:  "4k3/8/8/8/8/8/8/4K3[N] w - - 0 1",
+			move: Move{
+				Destination:   Position{Rank: 2, File: 5},
+				MoveType:      DROP,
+				DropPieceType: KNIGHT,
+			},
+			expectedAvailable: true,
+		},
+		{
+			name: "Drop on an occupied square.",
+			fen:  "4k3/8/8/8/8/8/8/4K3[N] w - - 0 1",
+			move: Move{
+				Destination:   Position{Rank: 0, File: 4},
+				MoveType:      DROP,
+				DropPieceType: KNIGHT,
+			},
+			expectedAvailable: false,
+		},
+		{
+			name: "Drop of a piece not in the reserve.",
+			fen:  "4k3/8/8/8/8/8/8/4K3[N] w - - 0 1",
+			move: Move{
+				Destination:   Position{Rank: 2, File: 5},
+				MoveType:      DROP,
+				DropPieceType: QUEEN,
+			},
+			expectedAvailable: false,
+		},
+		{
+			name: "Drop of the opponent's piece.",
+			fen:  "4k3/8/8/8/8/8/8/4K3[n] w - - 0 1",
+			move: Move{
+				Destination:   Position{Rank: 2, File: 5},
+				MoveType:      DROP,
+				DropPieceType: KNIGHT,
+			},
+			expectedAvailable: false,
+		},
+		{
+			name: "Pawn drop on the second rank.",
+			fen:  "4k3/8/8/8/8/8/8/4K3[P] w - - 0 1",
+			move: Move{
+				Destination:   Position{Rank: 1, File: 0},
+				MoveType:      DROP,
+				DropPieceType: PAWN,
+			},
+			expectedAvailable: true,
+		},
+		{
+			name: "Pawn drop on the first rank.",
+			fen:  "4k3/8/8/8/8/8/8/4K3[P] w - - 0 1",
+			move: Move{
+				Destination:   Position{Rank: 0, File: 0},
+				MoveType:      DROP,
+				DropPieceType: PAWN,
+			},
+			expectedAvailable: false,
+		},
+		{
+			name: "Pawn drop on the last rank.",
+			fen:  "4k3/8/8/8/8/8/8/4K3[p] b - - 0 1",
+			move: Move{
+				Destination:   Position{Rank: 7, File: 0},
+				MoveType:      DROP,
+				DropPieceType: PAWN,
+			},
+			expectedAvailable: false,
+		},
+		{
+			name: "Drop blocking check.",
+			fen:  "4k3/8/8/8/8/8/8/r3K3[N] w - - 0 1",
+			move: Move{
+				Destination:   Position{Rank: 0, File: 3},
+				MoveType:      DROP,
+				DropPieceType: KNIGHT,
+			},
+			expectedAvailable: true,
+		},
+		{
+			name: "Drop not blocking check.",
+			fen:  "4k3/8/8/8/8/8/8/r3K3[N] w - - 0 1",
+			move: Move{
+				Destination:   Position{Rank: 2, File: 5},
+				MoveType:      DROP,
+				DropPieceType: KNIGHT,
+			},
+			expectedAvailable: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			board := newReserveBoard(t, tc.fen)
+			assert.Equal(t, tc.expectedAvailable, board.IsAvailableDrop(tc.move))
+		})
+	}
+}
+
+func TestDropEscapes(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		fen                  string
+		expectedSAN          string
+		expectedGameEndState GameEndState
+	}{
+		{
+			name:        "Back rank mate.",
+			fen:         "r5k1/5ppp/8/8/8/8/5PPP/6K1[] b - - 0 1",
+			expectedSAN: "Ra1#",
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateCheckmate,
+				Winner:       BLACK,
+				Loser:        WHITE,
+			},
+		},
+		{
+			name:        "Back rank check blocked by a drop.",
+			fen:         "r5k1/5ppp/8/8/8/8/5PPP/6K1[N] b - - 0 1",
+			expectedSAN: "Ra1+",
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateNone,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			board := newReserveBoard(t, tc.fen)
+			move := Move{
+				Source:      Position{Rank: 7, File: 0},
+				Destination: Position{Rank: 0, File: 0},
+				MoveType:    NORMAL,
+			}
+
+			san, err := board.MoveToSAN(move)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedSAN, san)
+			assert.Nil(t, board.HandleMove(move))
+			assert.Equal(t, tc.expectedGameEndState, board.GetGameEndState())
+		})
+	}
+}
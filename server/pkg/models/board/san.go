@@ -0,0 +1,394 @@
+package board
+
+import (
+	"regexp"
+	"strings"
+)
+
+// sanCastles maps castling move types to their notation.
+var sanCastles = map[MoveType]string{
+	KINGSIDE_CASTLE:  "O-O",
+	QUEENSIDE_CASTLE: "O-O-O",
+}
+
+// sanPattern matches SAN and LAN moves, e.g. "Nxe5", "exd8=N", "Ng1-f3" or "e2e4".
+// The groups are the piece letter, source file, source rank, separator,
+// destination square and promotion piece letter. The source file is lazy
+// so the capture separator isn't mistaken for the x file. Any uppercase letter
+// is matched so fairy pieces can be written, unknown letters are rejected when parsed.
+var sanPattern = regexp.MustCompile(`^([A-Z])?([a-z])??([0-9]+)?([-x])?([a-z][0-9]+)(?:=?([A-Z]))?$`)
+
+// sanDropPattern matches drops, e.g. "N@f3" or "@e4" for a pawn, unknown piece letters are rejected when parsed.
+// The groups are the piece letter and destination square.
+var sanDropPattern = regexp.MustCompile(`^([A-Z])?@([a-z][0-9]+)$`)
+
+// MoveToSAN returns the Standard Algebraic Notation of the Move, e.g. "Nxe5".
+// The Move must be available on the Board.
+func (b *Board) MoveToSAN(move Move) (string, error) {
+	if move.MoveType == DROP {
+		return b.dropToSAN(move)
+	}
+
+	piece, err := b.getMovingPiece(move)
+	if err != nil {
+		return "", err
+	}
+
+	if castle, ok := sanCastles[move.MoveType]; ok {
+		return castle + b.checkSuffix(move), nil
+	}
+
+	builder := strings.Builder{}
+	isCapture := b.isCaptureMove(move)
+	if piece.PieceType == PAWN {
+		if isCapture {
+			builder.WriteString(fileName(move.Source.File))
+		}
+	} else {
+		builder.WriteRune(pieceTypeLetter(piece.PieceType))
+		builder.WriteString(b.disambiguation(move, piece))
+	}
+	if isCapture {
+		builder.WriteString("x")
+	}
+	builder.WriteString(PositionToSquare(move.Destination))
+	builder.WriteString(promotionSuffix(move))
+	builder.WriteString(b.checkSuffix(move))
+
+	return builder.String(), nil
+}
+
+// MoveToLAN returns the Long Algebraic Notation of the Move, e.g. "Ng1-f3".
+// The Move must be available on the Board.
+func (b *Board) MoveToLAN(move Move) (string, error) {
+	if move.MoveType == DROP {
+		return b.dropToSAN(move)
+	}
+
+	piece, err := b.getMovingPiece(move)
+	if err != nil {
+		return "", err
+	}
+
+	if castle, ok := sanCastles[move.MoveType]; ok {
+		return castle + b.checkSuffix(move), nil
+	}
+
+	builder := strings.Builder{}
+	if piece.PieceType != PAWN {
+		builder.WriteRune(pieceTypeLetter(piece.PieceType))
+	}
+	builder.WriteString(PositionToSquare(move.Source))
+	if b.isCaptureMove(move) {
+		builder.WriteString("x")
+	} else {
+		builder.WriteString("-")
+	}
+	builder.WriteString(PositionToSquare(move.Destination))
+	builder.WriteString(promotionSuffix(move))
+	builder.WriteString(b.checkSuffix(move))
+
+	return builder.String(), nil
+}
+
+// ParseSAN returns the Move for the active player described by the SAN or LAN string.
+// Captures must use the x separator, check, mate and annotation suffixes are ignored.
+func (b *Board) ParseSAN(san string) (Move, error) {
+	notation := strings.TrimRight(san, "+#!?")
+
+	// Castles are matched by their move type, zeros are accepted in place of the letter O.
+	for moveType, castle := range sanCastles {
+		if strings.ReplaceAll(notation, "0", "O") == castle {
+			castleType := moveType
+			return b.matchSAN(san, NONE, func(piece *Piece, source Position, move Move) bool {
+				return piece.PieceType == KING && move.MoveType == castleType
+			})
+		}
+	}
+
+	if groups := sanDropPattern.FindStringSubmatch(notation); groups != nil {
+		return b.parseSANDrop(san, groups)
+	}
+
+	groups := sanPattern.FindStringSubmatch(notation)
+	if groups == nil {
+		return Move{}, errInvalidSAN(san, "invalid format")
+	}
+
+	pieceType := PAWN
+	if groups[1] != "" {
+		letterType, ok := letterPieceType(rune(groups[1][0]))
+		if !ok || letterType == PAWN {
+			return Move{}, errInvalidSAN(san, "invalid piece")
+		}
+		pieceType = letterType
+	}
+	destination, err := SquareToPosition(groups[5])
+	if err != nil {
+		return Move{}, errInvalidSAN(san, "invalid destination")
+	}
+	promotionPieceType := NONE
+	if groups[6] != "" {
+		letterType, ok := letterPieceType(rune(groups[6][0]))
+		if !ok {
+			return Move{}, errInvalidSAN(san, "invalid promotion piece")
+		}
+		promotionPieceType = letterType
+	}
+
+	return b.matchSAN(san, promotionPieceType, func(piece *Piece, source Position, move Move) bool {
+		if piece.PieceType != pieceType || move.Destination != destination {
+			return false
+		}
+		if _, ok := sanCastles[move.MoveType]; ok {
+			return false
+		}
+		if groups[2] != "" && fileName(source.File) != groups[2] {
+			return false
+		}
+		if groups[3] != "" && rankName(source.Rank) != groups[3] {
+			return false
+		}
+		// Captures are written with the x separator, other moves can't be.
+		if (groups[4] == "x") != b.isCaptureMove(move) {
+			return false
+		}
+
+		isPromotion := move.MoveType == PROMOTION || move.MoveType == PROMOTION_CAPTURE
+		return promotionPieceType == NONE || isPromotion
+	})
+}
+
+// matchSAN returns the only available Move of the active player that satisfies the predicate,
+// promotions are made to the provided PieceType.
+func (b *Board) matchSAN(
+	san string,
+	promotionPieceType PieceType,
+	predicate func(piece *Piece, source Position, move Move) bool,
+) (Move, error) {
+	matches := []Move{}
+	b.forEachPiece(
+		b.GameboardState,
+		func(source Position, piece *Piece) {
+			if piece == nil || piece.Color != b.GetActivePlayer() {
+				return
+			}
+			for moveType, destinations := range piece.AvailableMoves {
+				for _, destination := range destinations {
+					move := Move{
+						Source:      source,
+						Destination: destination,
+						MoveType:    moveType,
+					}
+					if moveType == PROMOTION || moveType == PROMOTION_CAPTURE {
+						move.PromotionPieceType = promotionPieceType
+					}
+					if predicate(piece, source, move) && b.IsLegalMove(move, b.GameboardState) {
+						matches = append(matches, move)
+					}
+				}
+			}
+		},
+	)
+
+	switch len(matches) {
+	case 0:
+		return Move{}, errInvalidSAN(san, "no matching move")
+	case 1:
+		return matches[0], nil
+	default:
+		return Move{}, errInvalidSAN(san, "ambiguous move")
+	}
+}
+
+// parseSANDrop returns the drop of the active player described by the groups of sanDropPattern.
+func (b *Board) parseSANDrop(san string, groups []string) (Move, error) {
+	pieceType := PAWN
+	if groups[1] != "" {
+		letterType, ok := letterPieceType(rune(groups[1][0]))
+		if !ok {
+			return Move{}, errInvalidSAN(san, "invalid piece")
+		}
+		pieceType = letterType
+	}
+	destination, err := SquareToPosition(groups[2])
+	if err != nil {
+		return Move{}, errInvalidSAN(san, "invalid destination")
+	}
+
+	move := Move{
+		Destination:   destination,
+		MoveType:      DROP,
+		DropPieceType: pieceType,
+	}
+	if b.ReserveState == nil || !b.IsAvailableDrop(move) {
+		return Move{}, errInvalidSAN(san, "no matching move")
+	}
+	return move, nil
+}
+
+// dropToSAN returns the notation of the drop, e.g. "N@f3", it's the same in SAN and LAN.
+// The drop must be available on the Board.
+func (b *Board) dropToSAN(move Move) (string, error) {
+	if b.ReserveState == nil || !b.IsAvailableDrop(move) {
+		return "", errMoveNotAllowed
+	}
+	return string(pieceTypeLetter(move.DropPieceType)) +
+		"@" +
+		PositionToSquare(move.Destination) +
+		b.checkSuffix(move), nil
+}
+
+// getMovingPiece returns the Piece making the Move if the Move is available.
+func (b *Board) getMovingPiece(move Move) (*Piece, error) {
+	piece := b.GameboardState[move.Source.Rank][move.Source.File]
+	if piece == nil {
+		return nil, errPieceNotFound
+	}
+	if !piece.IsAvailableMove(move) {
+		return nil, errMoveNotAllowed
+	}
+	return piece, nil
+}
+
+// disambiguation returns the source file, rank or square needed to distinguish the Move
+// from moves to the same destination by other pieces of the same type and color.
+func (b *Board) disambiguation(move Move, piece *Piece) string {
+	ambiguous, sameFile, sameRank := false, false, false
+	b.forEachPiece(
+		b.GameboardState,
+		func(source Position, other *Piece) {
+			if other == nil ||
+				source == move.Source ||
+				other.Color != piece.Color ||
+				other.PieceType != piece.PieceType {
+				return
+			}
+			for _, destinations := range other.AvailableMoves {
+				for _, destination := range destinations {
+					if destination == move.Destination {
+						ambiguous = true
+						sameFile = sameFile || source.File == move.Source.File
+						sameRank = sameRank || source.Rank == move.Source.Rank
+					}
+				}
+			}
+		},
+	)
+
+	switch {
+	case !ambiguous:
+		return ""
+	case !sameFile:
+		return fileName(move.Source.File)
+	case !sameRank:
+		return rankName(move.Source.Rank)
+	default:
+		return PositionToSquare(move.Source)
+	}
+}
+
+// promotionSuffix returns the promoted piece of the Move, e.g. "=N".
+func promotionSuffix(move Move) string {
+	if move.MoveType != PROMOTION && move.MoveType != PROMOTION_CAPTURE {
+		return ""
+	}
+	if move.PromotionPieceType == NONE {
+		return "=" + string(pieceTypeLetter(QUEEN))
+	}
+	return "=" + string(pieceTypeLetter(move.PromotionPieceType))
+}
+
+// checkSuffix returns "#" if the Move checkmates the next player or wins the game
+// through one of the Board's win conditions, "+" if it checks them.
+func (b *Board) checkSuffix(move Move) string {
+	isCheck, isMate := b.checkAfterMove(move)
+	switch {
+	case isMate || b.winsAfterMove(move, isCheck):
+		return "#"
+	case isCheck:
+		return "+"
+	default:
+		return ""
+	}
+}
+
+// winsAfterMove returns true if the active player meets one of the Board's win conditions once the Move is played,
+// isCheck is whether the Move gives check.
+func (b *Board) winsAfterMove(move Move, isCheck bool) bool {
+	if b.WinCondition == nil {
+		return false
+	}
+
+	state, err := b.ApplyMove(move, b.GameboardState)
+	if err != nil {
+		return false
+	}
+
+	// The check given by the Move is counted while the win conditions are checked.
+	if isCheck && b.CheckCountState != nil {
+		color := b.GetActivePlayer()
+		b.RecordCheck(color)
+		defer func() {
+			b.Checks[color] -= 1
+		}()
+	}
+
+	_, winner, ok := b.CheckWin(state)
+	return ok && winner == b.GetActivePlayer()
+}
+
+// checkAfterMove returns if the Move puts the next player in check and if it's checkmate.
+func (b *Board) checkAfterMove(move Move) (bool, bool) {
+	state, err := b.ApplyMove(move, b.GameboardState)
+	if err != nil {
+		return false, false
+	}
+
+	color := b.TurnOrder[0]
+	moves := b.generateLegalFilteredMoves(state)
+	if !b.isInCheck(color, state, moves) {
+		return false, false
+	}
+
+	// Look for a move of the next player that escapes check, castling can't.
+	hasEscape := false
+	b.forEachPiece(
+		state,
+		func(source Position, piece *Piece) {
+			if hasEscape || piece == nil || piece.Color != color {
+				return
+			}
+			for moveType, destinations := range moves[source.Rank][source.File] {
+				if _, ok := sanCastles[moveType]; ok {
+					continue
+				}
+				for _, destination := range destinations {
+					escapeState, err := b.ApplyMove(
+						Move{Source: source, Destination: destination, MoveType: moveType},
+						state,
+					)
+					if err != nil {
+						continue
+					}
+					if !isColorInCheck(color, escapeState, b.generateLegalFilteredMoves(escapeState)) {
+						hasEscape = true
+						return
+					}
+				}
+			}
+		},
+	)
+
+	// Drops from the reserve may block the check.
+	if !hasEscape && b.ReserveState != nil {
+		hasEscape = b.hasDropEscape(color, state)
+	}
+
+	return true, !hasEscape
+}
+
+// generateLegalFilteredMoves generates the possible moves in the state that pass the MoveFilter.
+func (b *Board) generateLegalFilteredMoves(state GameboardState) AvailableMoveMap {
+	return b.filterAvailableMoveMap(state, b.generatePossibleMoves(state), b.legalMoveFilterPredicate)
+}
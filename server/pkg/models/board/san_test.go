@@ -0,0 +1,546 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoveToSAN(t *testing.T) {
+	checkCountState := &CheckCountState{Checks: map[Color]int{WHITE: 2, BLACK: 0}}
+	testCases := []struct {
+		name        string
+		fen         string
+		options     []BuilderOption
+		move        Move
+		expectedSAN string
+		expectedLAN string
+	}{
+		{
+			name: "Knight move.",
+			fen:  startingFEN,
+			move: Move{
+				Source:      Position{Rank: 0, File: 6},
+				Destination: Position{Rank: 2, File: 5},
+				MoveType:    JUMP,
+			},
+			expectedSAN: "Nf3",
+			expectedLAN: "Ng1-f3",
+		},
+		{
+			name: "Pawn double push.",
+			fen:  startingFEN,
+			move: Move{
+				Source:      Position{Rank: 1, File: 4},
+				Destination: Position{Rank: 3, File: 4},
+				MoveType:    PAWN_DOUBLE_PUSH,
+			},
+			expectedSAN: "e4",
+			expectedLAN: "e2-e4",
+		},
+		{
+			name: "Knight disambiguated by file.",
+			fen:  "4k3/8/8/8/8/8/8/1N1K1N2 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 0, File: 1},
+				Destination: Position{Rank: 1, File: 3},
+				MoveType:    JUMP,
+			},
+			expectedSAN: "Nbd2",
+			expectedLAN: "Nb1-d2",
+		},
+		{
+			name: "Rook disambiguated by rank.",
+			fen:  "4k3/8/8/R7/8/8/8/R3K3 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 2, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedSAN: "R1a3",
+			expectedLAN: "Ra1-a3",
+		},
+		{
+			name: "Promotion capture with check.",
+			fen:  "3r4/4Pk2/8/8/8/8/8/4K3 w - - 0 1",
+			move: Move{
+				Source:             Position{Rank: 6, File: 4},
+				Destination:        Position{Rank: 7, File: 3},
+				MoveType:           PROMOTION_CAPTURE,
+				PromotionPieceType: KNIGHT,
+			},
+			expectedSAN: "exd8=N+",
+			expectedLAN: "e7xd8=N+",
+		},
+		{
+			name: "En passant.",
+			fen:  "4k3/8/8/3pP3/8/8/8/4K3 w - d6 0 1",
+			move: Move{
+				Source:      Position{Rank: 4, File: 4},
+				Destination: Position{Rank: 5, File: 3},
+				MoveType:    EN_PASSANT,
+			},
+			expectedSAN: "exd6",
+			expectedLAN: "e5xd6",
+		},
+		{
+			name: "Kingside castle.",
+			fen:  "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1",
+			move: Move{
+				Source:      Position{Rank: 0, File: 4},
+				Destination: Position{Rank: 0, File: 6},
+				MoveType:    KINGSIDE_CASTLE,
+			},
+			expectedSAN: "O-O",
+			expectedLAN: "O-O",
+		},
+		{
+			name: "Queenside castle.",
+			fen:  "r3k2r/8/8/8/8/8/8/R3K2R b KQkq - 0 1",
+			move: Move{
+				Source:      Position{Rank: 7, File: 4},
+				Destination: Position{Rank: 7, File: 2},
+				MoveType:    QUEENSIDE_CASTLE,
+			},
+			expectedSAN: "O-O-O",
+			expectedLAN: "O-O-O",
+		},
+		{
+			name: "Back rank checkmate.",
+			fen:  "6k1/5ppp/8/8/8/8/8/R3K3 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedSAN: "Ra8#",
+			expectedLAN: "Ra1-a8#",
+		},
+		{
+			name: "King capture.",
+			fen:  "4k3/4P3/8/8/8/8/8/4K3 b - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 7, File: 4},
+				Destination: Position{Rank: 6, File: 4},
+				MoveType:    CAPTURE,
+			},
+			expectedSAN: "Kxe7",
+			expectedLAN: "Ke8xe7",
+		},
+		{
+			name: "Third check.",
+			fen:  "4k3/8/8/8/8/8/8/R3K3 w - - 0 1",
+			options: []BuilderOption{
+				WithCheckCountState(checkCountState),
+				WithWinCondition(NewWinCondition(NewThreeCheckWinCondition(checkCountState))),
+			},
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedSAN: "Ra8#",
+			expectedLAN: "Ra1-a8#",
+		},
+		{
+			name: "King of the hill win.",
+			fen:  "4k3/8/8/8/8/4K3/8/8 w - - 0 1",
+			options: []BuilderOption{
+				WithWinCondition(NewWinCondition(NewKingOfTheHillWinCondition(Bounds{RankCount: 8, FileCount: 8}))),
+			},
+			move: Move{
+				Source:      Position{Rank: 2, File: 4},
+				Destination: Position{Rank: 3, File: 4},
+				MoveType:    NORMAL,
+			},
+			expectedSAN: "Ke4#",
+			expectedLAN: "Ke3-e4#",
+		},
+		{
+			name: "King of the hill move off the hill.",
+			fen:  "4k3/8/8/8/8/4K3/8/8 w - - 0 1",
+			options: []BuilderOption{
+				WithWinCondition(NewWinCondition(NewKingOfTheHillWinCondition(Bounds{RankCount: 8, FileCount: 8}))),
+			},
+			move: Move{
+				Source:      Position{Rank: 2, File: 4},
+				Destination: Position{Rank: 1, File: 4},
+				MoveType:    NORMAL,
+			},
+			expectedSAN: "Ke2",
+			expectedLAN: "Ke3-e2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, Bounds{RankCount: 8, FileCount: 8})
+			assert.Nil(t, err)
+			board := Build(append([]BuilderOption{WithFEN(position)}, tc.options...)...)
+
+			san, err := board.MoveToSAN(tc.move)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedSAN, san)
+
+			lan, err := board.MoveToLAN(tc.move)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedLAN, lan)
+		})
+	}
+}
+
+func TestMoveToSANNotAvailable(t *testing.T) {
+	board := Build()
+	_, err := board.MoveToSAN(Move{
+		Source:      Position{Rank: 0, File: 0},
+		Destination: Position{Rank: 2, File: 0},
+		MoveType:    NORMAL,
+	})
+	assert.NotNil(t, err)
+}
+
+func TestSANPattern(t *testing.T) {
+	testCases := []struct {
+		name           string
+		notation       string
+		expectedGroups []string
+	}{
+		{
+			name:           "Knight capture.",
+			notation:       "Nxe5",
+			expectedGroups: []string{"N", "", "", "x", "e5", ""},
+		},
+		{
+			name:           "King capture.",
+			notation:       "Kxe2",
+			expectedGroups: []string{"K", "", "", "x", "e2", ""},
+		},
+		{
+			name:           "Capture disambiguated by file.",
+			notation:       "Nbxd2",
+			expectedGroups: []string{"N", "b", "", "x", "d2", ""},
+		},
+		{
+			name:           "Capture disambiguated by rank.",
+			notation:       "R1xa3",
+			expectedGroups: []string{"R", "", "1", "x", "a3", ""},
+		},
+		{
+			name:           "Pawn capture onto the x file.",
+			notation:       "wxx4",
+			expectedGroups: []string{"", "w", "", "x", "x4", ""},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			groups := sanPattern.FindStringSubmatch(tc.notation)
+			assert.Equal(t, tc.expectedGroups, groups[1:])
+		})
+	}
+}
+
+func TestParseSAN(t *testing.T) {
+	testCases := []struct {
+		name         string
+		fen          string
+		san          string
+		expectedMove Move
+		expectErr    bool
+	}{
+		{
+			name: "Knight move.",
+			fen:  startingFEN,
+			san:  "Nf3",
+			expectedMove: Move{
+				Source:      Position{Rank: 0, File: 6},
+				Destination: Position{Rank: 2, File: 5},
+				MoveType:    JUMP,
+			},
+		},
+		{
+			name: "Pawn double push.",
+			fen:  startingFEN,
+			san:  "e4",
+			expectedMove: Move{
+				Source:      Position{Rank: 1, File: 4},
+				Destination: Position{Rank: 3, File: 4},
+				MoveType:    PAWN_DOUBLE_PUSH,
+			},
+		},
+		{
+			name: "Long algebraic notation.",
+			fen:  startingFEN,
+			san:  "Ng1-f3",
+			expectedMove: Move{
+				Source:      Position{Rank: 0, File: 6},
+				Destination: Position{Rank: 2, File: 5},
+				MoveType:    JUMP,
+			},
+		},
+		{
+			name: "Long algebraic notation without a separator.",
+			fen:  startingFEN,
+			san:  "e2e4",
+			expectedMove: Move{
+				Source:      Position{Rank: 1, File: 4},
+				Destination: Position{Rank: 3, File: 4},
+				MoveType:    PAWN_DOUBLE_PUSH,
+			},
+		},
+		{
+			name: "Disambiguated knight move.",
+			fen:  "4k3/8/8/8/8/8/8/1N1K1N2 w - - 0 1",
+			san:  "Nbd2",
+			expectedMove: Move{
+				Source:      Position{Rank: 0, File: 1},
+				Destination: Position{Rank: 1, File: 3},
+				MoveType:    JUMP,
+			},
+		},
+		{
+			name: "Promotion capture with check.",
+			fen:  "3r4/4Pk2/8/8/8/8/8/4K3 w - - 0 1",
+			san:  "exd8=N+",
+			expectedMove: Move{
+				Source:             Position{Rank: 6, File: 4},
+				Destination:        Position{Rank: 7, File: 3},
+				MoveType:           PROMOTION_CAPTURE,
+				PromotionPieceType: KNIGHT,
+			},
+		},
+		{
+			name: "Castle with zeros.",
+			fen:  "r3k2r/8/8/8/8/8/8/R3K2R b KQkq - 0 1",
+			san:  "0-0-0",
+			expectedMove: Move{
+				Source:      Position{Rank: 7, File: 4},
+				Destination: Position{Rank: 7, File: 2},
+				MoveType:    QUEENSIDE_CASTLE,
+			},
+		},
+		{
+			name: "Checkmate suffix.",
+			fen:  "6k1/5ppp/8/8/8/8/8/R3K3 w - - 0 1",
+			san:  "Ra8#",
+			expectedMove: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    NORMAL,
+			},
+		},
+		{
+			name: "Piece capture.",
+			fen:  "r1bqkb1r/pppp1ppp/2n2n2/4p2Q/2B1P3/8/PPPP1PPP/RNB1K1NR w KQkq - 4 4",
+			san:  "Qxf7#",
+			expectedMove: Move{
+				Source:      Position{Rank: 4, File: 7},
+				Destination: Position{Rank: 6, File: 5},
+				MoveType:    CAPTURE,
+			},
+		},
+		{
+			name: "Knight capture.",
+			fen:  "4k3/8/8/4p3/8/5N2/8/4K3 w - - 0 1",
+			san:  "Nxe5",
+			expectedMove: Move{
+				Source:      Position{Rank: 2, File: 5},
+				Destination: Position{Rank: 4, File: 4},
+				MoveType:    JUMP_CAPTURE,
+			},
+		},
+		{
+			name: "Bishop capture.",
+			fen:  "4k3/3p4/8/8/B7/8/8/4K3 w - - 0 1",
+			san:  "Bxd7+",
+			expectedMove: Move{
+				Source:      Position{Rank: 3, File: 0},
+				Destination: Position{Rank: 6, File: 3},
+				MoveType:    CAPTURE,
+			},
+		},
+		{
+			name: "Rook capture.",
+			fen:  "4k3/8/8/8/8/p7/8/R3K3 w - - 0 1",
+			san:  "Rxa3",
+			expectedMove: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 2, File: 0},
+				MoveType:    CAPTURE,
+			},
+		},
+		{
+			name: "Queen capture.",
+			fen:  "4k3/3p4/8/8/8/8/8/3QK3 w - - 0 1",
+			san:  "Qxd7+",
+			expectedMove: Move{
+				Source:      Position{Rank: 0, File: 3},
+				Destination: Position{Rank: 6, File: 3},
+				MoveType:    CAPTURE,
+			},
+		},
+		{
+			name: "Capture disambiguated by file.",
+			fen:  "4k3/8/8/8/8/8/3p4/1N3N1K w - - 0 1",
+			san:  "Nbxd2",
+			expectedMove: Move{
+				Source:      Position{Rank: 0, File: 1},
+				Destination: Position{Rank: 1, File: 3},
+				MoveType:    JUMP_CAPTURE,
+			},
+		},
+		{
+			name: "Capture disambiguated by rank.",
+			fen:  "4k3/8/8/R7/8/p7/8/R3K3 w - - 0 1",
+			san:  "R1xa3",
+			expectedMove: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 2, File: 0},
+				MoveType:    CAPTURE,
+			},
+		},
+		{
+			name:      "Ambiguous knight move.",
+			fen:       "4k3/8/8/8/8/8/8/1N1K1N2 w - - 0 1",
+			san:       "Nd2",
+			expectErr: true,
+		},
+		{
+			name:      "Move of the inactive player.",
+			fen:       startingFEN,
+			san:       "e5",
+			expectErr: true,
+		},
+		{
+			name:      "Castle not allowed.",
+			fen:       "r3k2r/8/8/8/8/8/8/R3K2R w kq - 0 1",
+			san:       "O-O",
+			expectErr: true,
+		},
+		{
+			name:      "Capture separator on a non-capture.",
+			fen:       "4k3/8/8/8/8/8/8/3QK3 w - - 0 1",
+			san:       "Qxd5",
+			expectErr: true,
+		},
+		{
+			name:      "Capture without a capture separator.",
+			fen:       "4k3/8/8/8/8/p7/8/R3K3 w - - 0 1",
+			san:       "Ra3",
+			expectErr: true,
+		},
+		{
+			name:      "Long algebraic capture without a capture separator.",
+			fen:       "4k3/8/8/3p4/4P3/8/8/4K3 w - - 0 1",
+			san:       "e4-d5",
+			expectErr: true,
+		},
+		{
+			name:      "Invalid format.",
+			fen:       startingFEN,
+			san:       "Xe4",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := ParseFEN(tc.fen, Bounds{RankCount: 8, FileCount: 8})
+			assert.Nil(t, err)
+			board := Build(WithFEN(position))
+
+			move, err := board.ParseSAN(tc.san)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedMove, move)
+		})
+	}
+}
+
+func TestDropSAN(t *testing.T) {
+	testCases := []struct {
+		name         string
+		fen          string
+		san          string
+		expectedMove Move
+		expectedSAN  string
+		expectErr    bool
+	}{
+		{
+			name: "Knight drop.",
+			fen:  "4k3/8/8/8/8/8/8/4K3[N] w - - 0 1",
+			san:  "N@f3",
+			expectedMove: Move{
+				Destination:   Position{Rank: 2, File: 5},
+				MoveType:      DROP,
+				DropPieceType: KNIGHT,
+			},
+			expectedSAN: "N@f3",
+		},
+		{
+			name: "Pawn drop without a letter.",
+			fen:  "4k3/8/8/8/8/8/8/4K3[P] w - - 0 1",
+			san:  "@e4",
+			expectedMove: Move{
+				Destination:   Position{Rank: 3, File: 4},
+				MoveType:      DROP,
+				DropPieceType: PAWN,
+			},
+			expectedSAN: "P@e4",
+		},
+		{
+			name: "Drop with check.",
+			fen:  "4k3/8/8/8/8/8/8/4K3[q] b - - 0 1",
+			san:  "Q@e4+",
+			expectedMove: Move{
+				Destination:   Position{Rank: 3, File: 4},
+				MoveType:      DROP,
+				DropPieceType: QUEEN,
+			},
+			expectedSAN: "Q@e4+",
+		},
+		{
+			name:      "Pawn drop on the first rank.",
+			fen:       "4k3/8/8/8/8/8/8/4K3[P] w - - 0 1",
+			san:       "P@a1",
+			expectErr: true,
+		},
+		{
+			name:      "Drop of a piece not in the reserve.",
+			fen:       "4k3/8/8/8/8/8/8/4K3[N] w - - 0 1",
+			san:       "Q@e4",
+			expectErr: true,
+		},
+		{
+			name:      "Drop of an unknown piece.",
+			fen:       "4k3/8/8/8/8/8/8/4K3[N] w - - 0 1",
+			san:       "X@e4",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			board := newReserveBoard(t, tc.fen)
+
+			move, err := board.ParseSAN(tc.san)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedMove, move)
+
+			san, err := board.MoveToSAN(move)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedSAN, san)
+
+			lan, err := board.MoveToLAN(move)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedSAN, lan)
+		})
+	}
+}
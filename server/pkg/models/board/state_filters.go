@@ -50,3 +50,56 @@ func (s *IllegalCheckStateFilter) IsLegalState(
 		predicateAttackingEnemyKing(color, state, availableMoveMap),
 	)
 }
+
+// IllegalExplosionStateFilter is used to verify if a king was exploded by its own player's capture,
+// or is in check in atomic chess.
+type IllegalExplosionStateFilter struct {
+	*TurnState
+}
+
+// IsLegalState checks if the provided Color's king was exploded or is in check.
+// Exploding the enemy king wins the game so it's legal even in check,
+// and kings next to each other can't be checked as capturing one would explode the other.
+func (s *IllegalExplosionStateFilter) IsLegalState(
+	color Color,
+	state GameboardState,
+	availableMoveMap AvailableMoveMap,
+) bool {
+	// Only check illegal states for active player.
+	if color != s.GetActivePlayer() {
+		return true
+	}
+
+	kings := findKings(state)
+	king, ok := kings[color]
+	if !ok {
+		return false
+	}
+	if len(kings) == 1 {
+		return true
+	}
+	for kingColor, enemyKing := range kings {
+		if kingColor != color && abs(king.Rank-enemyKing.Rank) <= 1 && abs(king.File-enemyKing.File) <= 1 {
+			return true
+		}
+	}
+
+	return !anyPosition(
+		color,
+		state,
+		predicateAttackingEnemyKing(color, state, availableMoveMap),
+	)
+}
+
+// findKings returns the Position of the king of each Color on the GameboardState.
+func findKings(state GameboardState) map[Color]Position {
+	kings := map[Color]Position{}
+	for rank, files := range state {
+		for file, piece := range files {
+			if piece != nil && piece.PieceType == KING {
+				kings[piece.Color] = Position{Rank: rank, File: file}
+			}
+		}
+	}
+	return kings
+}
@@ -167,3 +167,115 @@ func TestIllegalCheckStateFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestIllegalExplosionStateFilter(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	rookCheck := AvailableMoveMap{
+		0: {
+			7: MoveMap{
+				CAPTURE: []Position{
+					{Rank: 0, File: 0},
+				},
+			},
+		},
+	}
+	testcases := []struct {
+		name                 string
+		color                Color
+		state                GameboardState
+		availableMoveMap     AvailableMoveMap
+		expectedIsLegalState bool
+	}{
+		{
+			name:  "Kings on the board is legal state.",
+			color: BLACK,
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {0: NewKing(BLACK)},
+					7: {7: NewKing(WHITE)},
+				}),
+			availableMoveMap:     NewAvailableMoveMap(bounds),
+			expectedIsLegalState: true,
+		},
+		{
+			name:  "Exploded own king is illegal state.",
+			color: BLACK,
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					7: {7: NewKing(WHITE)},
+				}),
+			availableMoveMap:     NewAvailableMoveMap(bounds),
+			expectedIsLegalState: false,
+		},
+		{
+			name:  "King in check is illegal state.",
+			color: BLACK,
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewKing(BLACK),
+						7: NewRook(WHITE, bounds),
+					},
+					7: {7: NewKing(WHITE)},
+				}),
+			availableMoveMap:     rookCheck,
+			expectedIsLegalState: false,
+		},
+		{
+			name:  "King in check after exploding the enemy king is legal state.",
+			color: BLACK,
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewKing(BLACK),
+						7: NewRook(WHITE, bounds),
+					},
+				}),
+			availableMoveMap:     rookCheck,
+			expectedIsLegalState: true,
+		},
+		{
+			name:  "King next to the enemy king can't be checked.",
+			color: BLACK,
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {
+						0: NewKing(BLACK),
+						7: NewRook(WHITE, bounds),
+					},
+					1: {1: NewKing(WHITE)},
+				}),
+			availableMoveMap:     rookCheck,
+			expectedIsLegalState: true,
+		},
+		{
+			name:  "Inactive player is legal state.",
+			color: WHITE,
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {0: NewKing(BLACK)},
+				}),
+			availableMoveMap:     NewAvailableMoveMap(bounds),
+			expectedIsLegalState: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			illegalExplosionStateFilter := IllegalExplosionStateFilter{
+				TurnState: &TurnState{
+					Active:    BLACK,
+					TurnOrder: []Color{WHITE, BLACK},
+				},
+			}
+			isLegalState := illegalExplosionStateFilter.IsLegalState(tc.color, tc.state, tc.availableMoveMap)
+			assert.Equal(t, tc.expectedIsLegalState, isLegalState)
+		})
+	}
+}
@@ -2,8 +2,154 @@ package variants
 
 import "github.com/variant64/server/pkg/models/board"
 
-type RequestNewClassicBoard struct{}
+// newBoardFromFEN creates a Board with the provided constructor,
+// starting from the FEN parsed on the Bounds unless the FEN is empty.
+func newBoardFromFEN(
+	fen string,
+	bounds board.Bounds,
+	newBoard func(options ...board.BuilderOption) *board.Board,
+) (*board.Board, error) {
+	if fen == "" {
+		return newBoard(), nil
+	}
+
+	position, err := board.ParseFEN(fen, bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBoard(board.WithFEN(position)), nil
+}
+
+type RequestNewClassicBoard struct {
+	// FEN is an optional starting position.
+	FEN string
+}
 
 func (r *RequestNewClassicBoard) PerformAction() (*board.Board, error) {
-	return NewClassicBoard(), nil
+	return newBoardFromFEN(r.FEN, classicBounds, NewClassicBoard)
+}
+
+type RequestNewChess960Board struct {
+	// Seed picks the starting position.
+	Seed int64
+	// FEN is an optional starting position, castling starts from its kings and rooks.
+	FEN string
+}
+
+func (r *RequestNewChess960Board) PerformAction() (*board.Board, error) {
+	if r.FEN == "" {
+		return NewChess960Board(r.Seed), nil
+	}
+
+	position, err := board.ParseFEN(r.FEN, classicBounds)
+	if err != nil {
+		return nil, err
+	}
+
+	return newChess960Board(classicBounds, position.GameboardState, board.WithFEN(position)), nil
+}
+
+type RequestNewCapablancaBoard struct {
+	// FEN is an optional starting position.
+	FEN string
+}
+
+func (r *RequestNewCapablancaBoard) PerformAction() (*board.Board, error) {
+	return newBoardFromFEN(r.FEN, capablancaBounds, NewCapablancaBoard)
+}
+
+type RequestNewGardnerBoard struct {
+	// FEN is an optional starting position.
+	FEN string
+}
+
+func (r *RequestNewGardnerBoard) PerformAction() (*board.Board, error) {
+	return newBoardFromFEN(r.FEN, gardnerBounds, NewGardnerBoard)
+}
+
+type RequestNewLosAlamosBoard struct {
+	// FEN is an optional starting position.
+	FEN string
+}
+
+func (r *RequestNewLosAlamosBoard) PerformAction() (*board.Board, error) {
+	return newBoardFromFEN(r.FEN, losAlamosBounds, NewLosAlamosBoard)
+}
+
+type RequestNewKingOfTheHillBoard struct {
+	// FEN is an optional starting position.
+	FEN string
+}
+
+func (r *RequestNewKingOfTheHillBoard) PerformAction() (*board.Board, error) {
+	return newBoardFromFEN(r.FEN, classicBounds, NewKingOfTheHillBoard)
+}
+
+type RequestNewThreeCheckBoard struct {
+	// FEN is an optional starting position, it may end with the check counts, e.g. +1+0.
+	FEN string
+}
+
+func (r *RequestNewThreeCheckBoard) PerformAction() (*board.Board, error) {
+	return newBoardFromFEN(r.FEN, classicBounds, NewThreeCheckBoard)
+}
+
+type RequestNewAtomicBoard struct {
+	// FEN is an optional starting position.
+	FEN string
+}
+
+func (r *RequestNewAtomicBoard) PerformAction() (*board.Board, error) {
+	return newBoardFromFEN(r.FEN, classicBounds, NewAtomicBoard)
+}
+
+type RequestNewAntichessBoard struct {
+	// FEN is an optional starting position.
+	FEN string
+}
+
+func (r *RequestNewAntichessBoard) PerformAction() (*board.Board, error) {
+	return newBoardFromFEN(r.FEN, classicBounds, NewAntichessBoard)
+}
+
+type RequestNewCrazyhouseBoard struct {
+	// FEN is an optional starting position, the piece placement may end with the reserves, e.g. [Qp].
+	FEN string
+}
+
+func (r *RequestNewCrazyhouseBoard) PerformAction() (*board.Board, error) {
+	return newBoardFromFEN(r.FEN, classicBounds, NewCrazyhouseBoard)
+}
+
+type RequestNewFourPlayerBoard struct{}
+
+func (r *RequestNewFourPlayerBoard) PerformAction() (*board.Board, error) {
+	return NewFourPlayerBoard(), nil
+}
+
+type RequestNewCylinderBoard struct {
+	// FEN is an optional starting position.
+	FEN string
+}
+
+func (r *RequestNewCylinderBoard) PerformAction() (*board.Board, error) {
+	return newBoardFromFEN(r.FEN, cylinderBounds, NewCylinderBoard)
+}
+
+type RequestNewTorusBoard struct {
+	// FEN is an optional starting position.
+	FEN string
+}
+
+func (r *RequestNewTorusBoard) PerformAction() (*board.Board, error) {
+	return newBoardFromFEN(r.FEN, torusBounds, NewTorusBoard)
+}
+
+// RequestGetVariants is used to get all supported Variants.
+type RequestGetVariants struct{}
+
+// PerformAction gets all registered Variants.
+func (r *RequestGetVariants) PerformAction() ([]*Variant, error) {
+	return GetVariants(), nil
 }
@@ -11,3 +11,72 @@ func TestRequestNewClassicBoard(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, classicBoard)
 }
+
+func TestRequestNewClassicBoardFEN(t *testing.T) {
+	testCases := []struct {
+		name      string
+		fen       string
+		expectErr bool
+	}{
+		{
+			name: "Valid FEN.",
+			fen:  "r3k2r/8/8/8/8/8/8/R3K2R b Kq - 3 20",
+		},
+		{
+			name:      "Invalid FEN.",
+			fen:       "r3k2r/8/8/8/8/8/R3K2R b Kq - 3 20",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			classicBoard, err := (&RequestNewClassicBoard{FEN: tc.fen}).PerformAction()
+			if tc.expectErr {
+				assert.Nil(t, classicBoard)
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.fen, classicBoard.FEN())
+		})
+	}
+}
+
+func TestRequestNewChess960Board(t *testing.T) {
+	testCases := []struct {
+		name        string
+		request     RequestNewChess960Board
+		expectedFEN string
+		expectErr   bool
+	}{
+		{
+			name:        "Seeded position.",
+			request:     RequestNewChess960Board{Seed: 7},
+			expectedFEN: NewChess960Board(7).FEN(),
+		},
+		{
+			name:        "FEN position.",
+			request:     RequestNewChess960Board{FEN: "bbqnnrkr/pppppppp/8/8/8/8/PPPPPPPP/BBQNNRKR w KQkq - 0 1"},
+			expectedFEN: "bbqnnrkr/pppppppp/8/8/8/8/PPPPPPPP/BBQNNRKR w KQkq - 0 1",
+		},
+		{
+			name:      "Invalid FEN.",
+			request:   RequestNewChess960Board{FEN: "bbqnnrkr/pppppppp/8/8/8/PPPPPPPP/BBQNNRKR w KQkq - 0 1"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chess960Board, err := tc.request.PerformAction()
+			if tc.expectErr {
+				assert.Nil(t, chess960Board)
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedFEN, chess960Board.FEN())
+		})
+	}
+}
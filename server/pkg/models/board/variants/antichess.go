@@ -0,0 +1,81 @@
+package variants
+
+import (
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func init() {
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeAntichess,
+		Description: "Losing chess where captures are compulsory, the king is an ordinary piece and a player wins by losing all their pieces or being stalemated.",
+		Bounds:      classicBounds,
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return (&RequestNewAntichessBoard{FEN: options.FEN}).PerformAction()
+		},
+	})
+}
+
+// NewAntichessBoard creates a new Board with antichess rules and returns it,
+// the options are applied after the antichess setup.
+// Captures are compulsory, kings can be captured and pawns may promote to a king,
+// a player wins once they have no moves left. Kings don't castle.
+func NewAntichessBoard(options ...board.BuilderOption) *board.Board {
+	bounds := classicBounds
+	antichessOptions := append(
+		antichessRules(bounds),
+		board.WithGameboardState(
+			board.GameboardState{
+				7: antichessBackRank(board.BLACK, bounds),
+				6: pawnRank(board.BLACK, bounds),
+				1: pawnRank(board.WHITE, bounds),
+				0: antichessBackRank(board.WHITE, bounds),
+			},
+		),
+	)
+	return board.Build(append(antichessOptions, options...)...)
+}
+
+// antichessRules returns the BuilderOptions of the antichess rules on the provided Bounds.
+func antichessRules(bounds board.Bounds) []board.BuilderOption {
+	promotionPieceTypes := board.DefaultPromotionPieceTypes()
+	promotionPieceTypes[board.KING] = true
+	turnState := &board.TurnState{
+		Active:    board.WHITE,
+		TurnOrder: []board.Color{board.BLACK, board.WHITE},
+	}
+
+	return append(
+		classicRules(
+			bounds,
+			board.NewCastlingState(),
+			board.NewDefaultDoublePushRanks(bounds),
+			promotionPieceTypes,
+			nil,
+		),
+		board.WithRoyalKings(false),
+		board.WithIllegalStateFilter(board.NewIllegalStateFilter()),
+		board.WithCompulsoryCaptureRule(&board.CompulsoryCaptureRule{}),
+		board.WithWinCondition(
+			board.NewWinCondition(
+				&board.NoMovesWinCondition{TurnState: turnState},
+			),
+		),
+		board.WithInsufficientMaterialRule(nil),
+		board.WithTurnState(turnState),
+	)
+}
+
+// antichessBackRank returns the back rank pieces of the Color, RNBQKBNR from the a-file.
+func antichessBackRank(color board.Color, bounds board.Bounds) map[int]*board.Piece {
+	return map[int]*board.Piece{
+		0: board.NewRook(color, bounds),
+		1: board.NewKnight(color),
+		2: board.NewBishop(color, bounds),
+		3: board.NewQueen(color, bounds),
+		4: board.NewKing(color),
+		5: board.NewBishop(color, bounds),
+		6: board.NewKnight(color),
+		7: board.NewRook(color, bounds),
+	}
+}
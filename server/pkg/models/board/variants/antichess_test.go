@@ -0,0 +1,100 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func TestNewAntichessBoard(t *testing.T) {
+	antichessBoard := NewAntichessBoard()
+	assert.Equal(t, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w - - 0 1", antichessBoard.FEN())
+}
+
+func TestAntichessMoves(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		fen                  string
+		san                  string
+		expectErr            bool
+		expectedFEN          string
+		expectedGameEndState board.GameEndState
+	}{
+		{
+			name:        "Capture is compulsory.",
+			fen:         "rnbqkbnr/p1pppppp/8/1p6/8/4P3/PPPP1PPP/RNBQKBNR w - - 0 2",
+			san:         "Bxb5",
+			expectedFEN: "rnbqkbnr/p1pppppp/8/1B6/8/4P3/PPPP1PPP/RNBQK1NR b - - 0 2",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+		},
+		{
+			name:      "Non capture not allowed when a capture exists.",
+			fen:       "rnbqkbnr/p1pppppp/8/1p6/8/4P3/PPPP1PPP/RNBQKBNR w - - 0 2",
+			san:       "a3",
+			expectErr: true,
+		},
+		{
+			name:        "King moves into an attacked square.",
+			fen:         "4k3/8/8/8/8/8/8/3RK3 b - - 0 1",
+			san:         "Kd8",
+			expectedFEN: "3k4/8/8/8/8/8/8/3RK3 w - - 1 2",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+		},
+		{
+			name:        "Promotion to king.",
+			fen:         "8/P7/8/8/8/8/8/7k w - - 0 1",
+			san:         "a8=K",
+			expectedFEN: "K7/8/8/8/8/8/8/7k b - - 0 1",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+		},
+		{
+			name:        "Losing all pieces wins.",
+			fen:         "8/8/8/8/8/8/3k4/4K3 w - - 0 1",
+			san:         "Kxd2",
+			expectedFEN: "8/8/8/8/8/8/3K4/8 b - - 0 1",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNoMovesLeft,
+				Winner:       board.BLACK,
+				Loser:        board.WHITE,
+			},
+		},
+		{
+			name:        "Being stalemated wins.",
+			fen:         "8/8/8/8/p7/8/P7/8 w - - 0 1",
+			san:         "a3",
+			expectedFEN: "8/8/8/8/p7/P7/8/8 b - - 0 1",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNoMovesLeft,
+				Winner:       board.BLACK,
+				Loser:        board.WHITE,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			antichessBoard, err := (&RequestNewAntichessBoard{FEN: tc.fen}).PerformAction()
+			assert.Nil(t, err)
+
+			move, err := antichessBoard.ParseSAN(tc.san)
+			if tc.expectErr {
+				if err == nil {
+					assert.NotNil(t, antichessBoard.HandleMove(move))
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Nil(t, antichessBoard.HandleMove(move))
+			assert.Equal(t, tc.expectedFEN, antichessBoard.FEN())
+			assert.Equal(t, tc.expectedGameEndState, antichessBoard.GetGameEndState())
+			assert.Equal(t, board.CheckStateNone, antichessBoard.GetCheckState())
+		})
+	}
+}
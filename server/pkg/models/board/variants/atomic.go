@@ -0,0 +1,81 @@
+package variants
+
+import (
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func init() {
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeAtomic,
+		Description: "Standard chess where captures explode the surrounding pieces and a player wins by exploding the enemy king.",
+		Bounds:      classicBounds,
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return (&RequestNewAtomicBoard{FEN: options.FEN}).PerformAction()
+		},
+	})
+}
+
+// NewAtomicBoard creates a new Board with atomic rules and returns it,
+// the options are applied after the atomic setup.
+// A capture explodes the capturing piece and every piece but pawns next to the destination,
+// kings can't capture and a player wins by exploding the enemy king.
+func NewAtomicBoard(options ...board.BuilderOption) *board.Board {
+	bounds := classicBounds
+	return NewClassicBoard(append(atomicRules(bounds), options...)...)
+}
+
+// atomicRules returns the BuilderOptions replacing the classic rules with the atomic rules on the provided Bounds.
+func atomicRules(bounds board.Bounds) []board.BuilderOption {
+	castlingState := board.NewCastlingState(board.NewDefaultCastlingRules(bounds)...)
+	enPassantState := board.NewDefaultEnPassantState()
+	turnState := &board.TurnState{
+		Active:    board.WHITE,
+		TurnOrder: []board.Color{board.BLACK, board.WHITE},
+	}
+
+	return []board.BuilderOption{
+		board.WithCastlingState(castlingState),
+		board.WithEnPassantState(enPassantState),
+		board.WithMoveApplicator(
+			board.NewMoveApplicator(
+				&board.SinglePieceMoveApplicator{},
+				&board.KingsideCastleMoveApplicator{CastlingState: castlingState},
+				&board.QueensideCastleMoveApplicator{CastlingState: castlingState},
+				&board.PromotionMoveApplicator{Bounds: bounds},
+				&board.EnPassantMoveApplicator{EnPassantState: enPassantState},
+				&board.ExplosionMoveApplicator{
+					Bounds:            bounds,
+					CaptureApplicator: classicMoveApplicator(bounds, castlingState, enPassantState, nil),
+				},
+			),
+		),
+		board.WithMoveFilter(
+			board.NewMoveFilter(
+				classicMoveFilter(
+					bounds,
+					castlingState,
+					enPassantState,
+					board.NewDefaultDoublePushRanks(bounds),
+					board.DefaultPromotionPieceTypes(),
+				),
+				&board.FilterKingCapture{},
+			),
+		),
+		board.WithIllegalStateFilter(
+			board.NewIllegalStateFilter(
+				&board.IllegalExplosionStateFilter{
+					TurnState: turnState,
+				},
+			),
+		),
+		board.WithWinCondition(
+			board.NewWinCondition(
+				&board.KingExplosionWinCondition{},
+			),
+		),
+		// A single piece can explode a bare king.
+		board.WithInsufficientMaterialRule(nil),
+		board.WithTurnState(turnState),
+	}
+}
@@ -0,0 +1,145 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func TestNewAtomicBoard(t *testing.T) {
+	atomicBoard := NewAtomicBoard()
+	assert.Equal(t, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", atomicBoard.FEN())
+}
+
+func TestAtomicMoves(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		fen                  string
+		move                 board.Move
+		expectErr            bool
+		expectedFEN          string
+		expectedGameEndState board.GameEndState
+	}{
+		{
+			name: "Pawn capture explodes both pawns.",
+			fen:  "rnbqkbnr/pppp1ppp/8/4p3/3P4/8/PPP1PPPP/RNBQKBNR w KQkq - 0 2",
+			move: board.Move{
+				Source:      board.Position{Rank: 3, File: 3},
+				Destination: board.Position{Rank: 4, File: 4},
+				MoveType:    board.CAPTURE,
+			},
+			expectedFEN: "rnbqkbnr/pppp1ppp/8/8/8/8/PPP1PPPP/RNBQKBNR b KQkq - 0 2",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+		},
+		{
+			name: "Exploding the enemy king wins.",
+			fen:  "4k3/4q3/8/8/8/8/8/4R1K1 w - - 0 1",
+			move: board.Move{
+				Source:      board.Position{Rank: 0, File: 4},
+				Destination: board.Position{Rank: 6, File: 4},
+				MoveType:    board.CAPTURE,
+			},
+			expectedFEN: "8/8/8/8/8/8/8/6K1 b - - 0 1",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateKingExploded,
+				Winner:       board.WHITE,
+				Loser:        board.BLACK,
+			},
+		},
+		{
+			name: "Exploding the enemy king wins while in check.",
+			fen:  "3rk3/8/8/8/4r3/8/8/3RK3 w - - 0 1",
+			move: board.Move{
+				Source:      board.Position{Rank: 0, File: 3},
+				Destination: board.Position{Rank: 7, File: 3},
+				MoveType:    board.CAPTURE,
+			},
+			expectedFEN: "8/8/8/8/4r3/8/8/4K3 b - - 0 1",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateKingExploded,
+				Winner:       board.WHITE,
+				Loser:        board.BLACK,
+			},
+		},
+		{
+			name: "Exploding the own king is not allowed.",
+			fen:  "4k3/8/8/8/8/8/3n4/3RK3 w - - 0 1",
+			move: board.Move{
+				Source:      board.Position{Rank: 0, File: 3},
+				Destination: board.Position{Rank: 1, File: 3},
+				MoveType:    board.CAPTURE,
+			},
+			expectErr: true,
+		},
+		{
+			name: "King capture not allowed.",
+			fen:  "4k3/8/8/8/8/8/4n3/4K3 w - - 0 1",
+			move: board.Move{
+				Source:      board.Position{Rank: 0, File: 4},
+				Destination: board.Position{Rank: 1, File: 4},
+				MoveType:    board.CAPTURE,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			atomicBoard, err := (&RequestNewAtomicBoard{FEN: tc.fen}).PerformAction()
+			assert.Nil(t, err)
+
+			err = atomicBoard.HandleMove(tc.move)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedFEN, atomicBoard.FEN())
+			assert.Equal(t, tc.expectedGameEndState, atomicBoard.GetGameEndState())
+		})
+	}
+}
+
+func TestAtomicMoveToSAN(t *testing.T) {
+	testCases := []struct {
+		name        string
+		fen         string
+		move        board.Move
+		expectedSAN string
+	}{
+		{
+			name: "Exploding the enemy king.",
+			fen:  "4k3/4q3/8/8/8/8/8/4R1K1 w - - 0 1",
+			move: board.Move{
+				Source:      board.Position{Rank: 0, File: 4},
+				Destination: board.Position{Rank: 6, File: 4},
+				MoveType:    board.CAPTURE,
+			},
+			expectedSAN: "Rxe7#",
+		},
+		{
+			name: "Capture away from the enemy king.",
+			fen:  "4k3/8/8/8/q7/8/8/R5K1 w - - 0 1",
+			move: board.Move{
+				Source:      board.Position{Rank: 0, File: 0},
+				Destination: board.Position{Rank: 3, File: 0},
+				MoveType:    board.CAPTURE,
+			},
+			expectedSAN: "Rxa4",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			atomicBoard, err := (&RequestNewAtomicBoard{FEN: tc.fen}).PerformAction()
+			assert.Nil(t, err)
+
+			san, err := atomicBoard.MoveToSAN(tc.move)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedSAN, san)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package variants
+
+import (
+	"github.com/variant64/server/pkg/models/board"
+)
+
+// capablancaBounds are the Bounds of the 10x8 Capablanca board.
+var capablancaBounds = board.Bounds{RankCount: 8, FileCount: 10}
+
+func init() {
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeCapablanca,
+		Description: "Chess on a 10x8 board with an archbishop moving as a bishop or knight and a chancellor moving as a rook or knight.",
+		Bounds:      capablancaBounds,
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return (&RequestNewCapablancaBoard{FEN: options.FEN}).PerformAction()
+		},
+	})
+}
+
+// NewCapablancaBoard creates a new Board with Capablanca rules on a 10x8 board and returns it,
+// the options are applied after the Capablanca setup.
+func NewCapablancaBoard(options ...board.BuilderOption) *board.Board {
+	bounds := capablancaBounds
+	castlingState := board.NewCastlingState(board.NewDefaultCastlingRules(bounds)...)
+
+	capablancaOptions := append(
+		classicRules(
+			bounds,
+			castlingState,
+			board.NewDefaultDoublePushRanks(bounds),
+			capablancaPromotionPieceTypes(),
+			nil,
+		),
+		board.WithGameboardState(
+			board.GameboardState{
+				7: capablancaBackRank(board.BLACK, bounds),
+				6: pawnRank(board.BLACK, bounds),
+				1: pawnRank(board.WHITE, bounds),
+				0: capablancaBackRank(board.WHITE, bounds),
+			},
+		),
+	)
+
+	return board.Build(append(capablancaOptions, options...)...)
+}
+
+// capablancaPromotionPieceTypes returns the PieceTypes a pawn can be promoted to in Capablanca chess.
+func capablancaPromotionPieceTypes() map[board.PieceType]bool {
+	promotionPieceTypes := board.DefaultPromotionPieceTypes()
+	promotionPieceTypes[board.ARCHBISHOP] = true
+	promotionPieceTypes[board.CHANCELLOR] = true
+	return promotionPieceTypes
+}
+
+// capablancaBackRank returns the back rank pieces of the Color, RNABQKBCNR from the a-file.
+func capablancaBackRank(color board.Color, bounds board.Bounds) map[int]*board.Piece {
+	return map[int]*board.Piece{
+		0: board.NewRook(color, bounds),
+		1: board.NewKnight(color),
+		2: board.NewArchbishop(color, bounds),
+		3: board.NewBishop(color, bounds),
+		4: board.NewQueen(color, bounds),
+		5: board.NewKing(color),
+		6: board.NewBishop(color, bounds),
+		7: board.NewChancellor(color, bounds),
+		8: board.NewKnight(color),
+		9: board.NewRook(color, bounds),
+	}
+}
+
+// pawnRank returns a rank of pawns of the Color across the Bounds.
+func pawnRank(color board.Color, bounds board.Bounds) map[int]*board.Piece {
+	pawns := map[int]*board.Piece{}
+	for file := 0; file < bounds.FileCount; file++ {
+		pawns[file] = board.NewPawn(color)
+	}
+	return pawns
+}
@@ -0,0 +1,73 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCapablancaBoard(t *testing.T) {
+	capablancaBoard := NewCapablancaBoard()
+	assert.Equal(t, "rnabqkbcnr/pppppppppp/10/10/10/10/PPPPPPPPPP/RNABQKBCNR w KQkq - 0 1", capablancaBoard.FEN())
+}
+
+func TestCapablancaMoves(t *testing.T) {
+	testCases := []struct {
+		name        string
+		fen         string
+		san         string
+		expectedFEN string
+	}{
+		{
+			name:        "Archbishop move.",
+			fen:         "rnabqkbcnr/pppppppppp/10/10/10/10/PPPPPPPPPP/RNABQKBCNR w KQkq - 0 1",
+			san:         "Ad3",
+			expectedFEN: "rnabqkbcnr/pppppppppp/10/10/10/3A6/PPPPPPPPPP/RN1BQKBCNR b KQkq - 1 1",
+		},
+		{
+			name:        "Chancellor move.",
+			fen:         "rnabqkbcnr/pppppppppp/10/10/10/10/PPPPPPPPPP/RNABQKBCNR w KQkq - 0 1",
+			san:         "Cg3",
+			expectedFEN: "rnabqkbcnr/pppppppppp/10/10/10/6C3/PPPPPPPPPP/RNABQKB1NR b KQkq - 1 1",
+		},
+		{
+			name:        "Kingside castle.",
+			fen:         "r4k3r/10/10/10/10/10/10/R4K3R w KQkq - 0 1",
+			san:         "O-O",
+			expectedFEN: "r4k3r/10/10/10/10/10/10/R6RK1 b kq - 1 1",
+		},
+		{
+			name:        "Queenside castle.",
+			fen:         "r4k3r/10/10/10/10/10/10/R4K3R b KQkq - 0 1",
+			san:         "O-O-O",
+			expectedFEN: "2kr5r/10/10/10/10/10/10/R4K3R w KQ - 1 2",
+		},
+		{
+			name:        "Promotion to archbishop.",
+			fen:         "5k4/1P8/10/10/10/10/10/5K4 w - - 0 1",
+			san:         "b8=A",
+			expectedFEN: "1A3k4/10/10/10/10/10/10/5K4 b - - 0 1",
+		},
+		{
+			name:        "Promotion to chancellor.",
+			fen:         "5k4/1P8/10/10/10/10/10/5K4 w - - 0 1",
+			san:         "b8=C+",
+			expectedFEN: "1C3k4/10/10/10/10/10/10/5K4 b - - 0 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			capablancaBoard, err := (&RequestNewCapablancaBoard{FEN: tc.fen}).PerformAction()
+			assert.Nil(t, err)
+
+			move, err := capablancaBoard.ParseSAN(tc.san)
+			assert.Nil(t, err)
+			san, err := capablancaBoard.MoveToSAN(move)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.san, san)
+			assert.Nil(t, capablancaBoard.HandleMove(move))
+			assert.Equal(t, tc.expectedFEN, capablancaBoard.FEN())
+		})
+	}
+}
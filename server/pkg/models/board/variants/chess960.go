@@ -0,0 +1,201 @@
+package variants
+
+import (
+	"math/rand"
+
+	"github.com/variant64/server/pkg/models/board"
+)
+
+// Chess960PositionCount is the number of Chess960 starting positions.
+const Chess960PositionCount = 960
+
+// chess960KnightPlacements lists the placements of both knights
+// on the five empty squares left after placing the bishops and queen.
+var chess960KnightPlacements = [][2]int{
+	{0, 1}, {0, 2}, {0, 3}, {0, 4}, {1, 2},
+	{1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4},
+}
+
+func init() {
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeChess960,
+		Description: "Chess with one of 960 randomized back ranks, the king is placed between the rooks and the bishops on opposite colors.",
+		Bounds:      classicBounds,
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return (&RequestNewChess960Board{Seed: options.Seed, FEN: options.FEN}).PerformAction()
+		},
+		StartingPositionID: GetChess960PositionID,
+	})
+}
+
+// NewChess960Board creates a new Board with one of the Chess960 starting positions
+// picked by the seed, the options are applied after the Chess960 setup.
+func NewChess960Board(seed int64, options ...board.BuilderOption) *board.Board {
+	// Every generated position ID is valid.
+	chess960Board, _ := NewChess960BoardFromID(Chess960PositionIDFromSeed(seed), options...)
+	return chess960Board
+}
+
+// NewChess960BoardFromID creates a new Board with the Chess960 starting position of the provided ID,
+// the options are applied after the Chess960 setup.
+func NewChess960BoardFromID(id int, options ...board.BuilderOption) (*board.Board, error) {
+	backRank, err := Chess960BackRank(id)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := classicBounds
+	state := board.GameboardState{0: {}, 1: {}, 6: {}, 7: {}}
+	for file, pieceType := range backRank {
+		state[0][file], _ = board.NewPieceOfType(board.WHITE, pieceType, bounds)
+		state[1][file] = board.NewPawn(board.WHITE)
+		state[6][file] = board.NewPawn(board.BLACK)
+		state[7][file], _ = board.NewPieceOfType(board.BLACK, pieceType, bounds)
+	}
+
+	return newChess960Board(bounds, state, options...), nil
+}
+
+// newChess960Board creates a new Board with classic rules on the provided state,
+// castling starts from the kings and rooks of the state.
+func newChess960Board(bounds board.Bounds, state board.GameboardState, options ...board.BuilderOption) *board.Board {
+	castlingState := board.NewCastlingState(Chess960CastlingRules(bounds, state)...)
+	chess960Options := append(
+		classicRules(
+			bounds,
+			castlingState,
+			board.NewDefaultDoublePushRanks(bounds),
+			board.DefaultPromotionPieceTypes(),
+			nil,
+		),
+		board.WithGameboardState(state),
+	)
+	return board.Build(append(chess960Options, options...)...)
+}
+
+// Chess960PositionIDFromSeed returns the Chess960 starting position ID picked by the seed.
+func Chess960PositionIDFromSeed(seed int64) int {
+	return rand.New(rand.NewSource(seed)).Intn(Chess960PositionCount)
+}
+
+// Chess960BackRank returns the back rank of the Chess960 starting position of the provided ID,
+// the IDs follow Scharnagl's numbering where 518 is the classic position.
+func Chess960BackRank(id int) ([]board.PieceType, error) {
+	if id < 0 || id >= Chess960PositionCount {
+		return nil, errInvalidChess960PositionID(id)
+	}
+
+	backRank := make([]board.PieceType, 8)
+	n := id
+
+	// Place the bishops on opposite colored squares.
+	backRank[2*(n%4)+1] = board.BISHOP
+	n /= 4
+	backRank[2*(n%4)] = board.BISHOP
+	n /= 4
+
+	// Place the queen and knights on the remaining empty squares.
+	placeOnEmptySquare(backRank, n%6, board.QUEEN)
+	n /= 6
+	knights := chess960KnightPlacements[n]
+	placeOnEmptySquare(backRank, knights[1], board.KNIGHT)
+	placeOnEmptySquare(backRank, knights[0], board.KNIGHT)
+
+	// The king is placed between the rooks.
+	placeOnEmptySquare(backRank, 0, board.ROOK)
+	placeOnEmptySquare(backRank, 0, board.KING)
+	placeOnEmptySquare(backRank, 0, board.ROOK)
+
+	return backRank, nil
+}
+
+// placeOnEmptySquare places the PieceType on the nth empty square of the back rank.
+func placeOnEmptySquare(backRank []board.PieceType, n int, pieceType board.PieceType) {
+	for file := range backRank {
+		if backRank[file] != board.NONE {
+			continue
+		}
+		if n == 0 {
+			backRank[file] = pieceType
+			return
+		}
+		n -= 1
+	}
+}
+
+// GetChess960PositionID returns the ID of the Chess960 starting position
+// that has the same white back rank as the provided state.
+func GetChess960PositionID(state board.GameboardState) (int, bool) {
+	for id := 0; id < Chess960PositionCount; id++ {
+		backRank, _ := Chess960BackRank(id)
+		matches := true
+		for file, pieceType := range backRank {
+			piece := state[0][file]
+			if piece == nil || piece.GetColor() != board.WHITE || piece.GetType() != pieceType {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// Chess960CastlingRules returns the CastlingRules of the kings and outermost rooks on each back rank,
+// whatever their start squares the king and rook land on the classic castled squares.
+func Chess960CastlingRules(bounds board.Bounds, state board.GameboardState) []board.CastlingRule {
+	rules := []board.CastlingRule{}
+	for _, player := range []struct {
+		color board.Color
+		rank  int
+	}{
+		{board.WHITE, 0},
+		{board.BLACK, bounds.RankCount - 1},
+	} {
+		kingFile, queensideRookFile, kingsideRookFile := -1, -1, -1
+		for file := 0; file < bounds.FileCount; file++ {
+			piece := state[player.rank][file]
+			if piece == nil || piece.GetColor() != player.color {
+				continue
+			}
+			switch {
+			case piece.GetType() == board.KING:
+				kingFile = file
+			case piece.GetType() == board.ROOK && kingFile < 0 && queensideRookFile < 0:
+				queensideRookFile = file
+			case piece.GetType() == board.ROOK && kingFile >= 0:
+				kingsideRookFile = file
+			}
+		}
+
+		if kingFile < 0 {
+			continue
+		}
+		if kingsideRookFile >= 0 {
+			rules = append(rules, board.NewCastlingRule(
+				board.KINGSIDE_CASTLE,
+				player.color,
+				player.rank,
+				kingFile,
+				kingsideRookFile,
+				bounds.FileCount-2,
+				bounds.FileCount-3,
+			))
+		}
+		if queensideRookFile >= 0 {
+			rules = append(rules, board.NewCastlingRule(
+				board.QUEENSIDE_CASTLE,
+				player.color,
+				player.rank,
+				kingFile,
+				queensideRookFile,
+				2,
+				3,
+			))
+		}
+	}
+	return rules
+}
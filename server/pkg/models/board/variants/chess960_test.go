@@ -0,0 +1,174 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func TestChess960BackRank(t *testing.T) {
+	testCases := []struct {
+		name             string
+		id               int
+		expectedBackRank []board.PieceType
+		expectErr        bool
+	}{
+		{
+			name: "First position.",
+			id:   0,
+			expectedBackRank: []board.PieceType{
+				board.BISHOP, board.BISHOP, board.QUEEN, board.KNIGHT,
+				board.KNIGHT, board.ROOK, board.KING, board.ROOK,
+			},
+		},
+		{
+			name: "Classic position.",
+			id:   518,
+			expectedBackRank: []board.PieceType{
+				board.ROOK, board.KNIGHT, board.BISHOP, board.QUEEN,
+				board.KING, board.BISHOP, board.KNIGHT, board.ROOK,
+			},
+		},
+		{
+			name: "Last position.",
+			id:   959,
+			expectedBackRank: []board.PieceType{
+				board.ROOK, board.KING, board.ROOK, board.KNIGHT,
+				board.KNIGHT, board.QUEEN, board.BISHOP, board.BISHOP,
+			},
+		},
+		{
+			name:      "Negative id.",
+			id:        -1,
+			expectErr: true,
+		},
+		{
+			name:      "Id out of range.",
+			id:        960,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backRank, err := Chess960BackRank(tc.id)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedBackRank, backRank)
+		})
+	}
+}
+
+func TestChess960BackRanksAreLegal(t *testing.T) {
+	seen := map[string]bool{}
+	for id := 0; id < Chess960PositionCount; id++ {
+		backRank, err := Chess960BackRank(id)
+		assert.Nil(t, err)
+
+		key := ""
+		bishopSquareColors := map[int]bool{}
+		rookFiles := []int{}
+		kingFile := -1
+		for file, pieceType := range backRank {
+			key += pieceType.String()
+			switch pieceType {
+			case board.BISHOP:
+				bishopSquareColors[file%2] = true
+			case board.ROOK:
+				rookFiles = append(rookFiles, file)
+			case board.KING:
+				kingFile = file
+			}
+		}
+
+		assert.False(t, seen[key], "duplicate position %d", id)
+		seen[key] = true
+		assert.Len(t, bishopSquareColors, 2, "bishops on the same color in position %d", id)
+		assert.Len(t, rookFiles, 2)
+		assert.True(t, rookFiles[0] < kingFile && kingFile < rookFiles[1], "king not between rooks in position %d", id)
+	}
+	assert.Len(t, seen, Chess960PositionCount)
+}
+
+func TestGetChess960PositionID(t *testing.T) {
+	for _, id := range []int{0, 518, 959} {
+		chess960Board, err := NewChess960BoardFromID(id)
+		assert.Nil(t, err)
+
+		foundID, ok := GetChess960PositionID(chess960Board.GetState())
+		assert.True(t, ok)
+		assert.Equal(t, id, foundID)
+	}
+
+	_, ok := GetChess960PositionID(NewClassicBoard(board.WithGameboardState(board.GameboardState{})).GetState())
+	assert.False(t, ok)
+}
+
+func TestNewChess960Board(t *testing.T) {
+	first := NewChess960Board(42)
+	second := NewChess960Board(42)
+	assert.Equal(t, first.FEN(), second.FEN())
+
+	id, ok := GetChess960PositionID(first.GetState())
+	assert.True(t, ok)
+	assert.Equal(t, Chess960PositionIDFromSeed(42), id)
+
+	classicBoard, err := NewChess960BoardFromID(518)
+	assert.Nil(t, err)
+	assert.Equal(t, NewClassicBoard().FEN(), classicBoard.FEN())
+}
+
+func TestChess960Castling(t *testing.T) {
+	testCases := []struct {
+		name        string
+		fen         string
+		san         string
+		expectedFEN string
+		expectErr   bool
+	}{
+		{
+			name:        "Kingside castle with the king on its destination.",
+			fen:         "1r4kr/8/8/8/8/8/8/1R4KR w KQkq - 0 1",
+			san:         "O-O",
+			expectedFEN: "1r4kr/8/8/8/8/8/8/1R3RK1 b kq - 1 1",
+		},
+		{
+			name:        "Queenside castle across the board.",
+			fen:         "1r4kr/8/8/8/8/8/8/1R4KR w KQkq - 0 1",
+			san:         "O-O-O",
+			expectedFEN: "1r4kr/8/8/8/8/8/8/2KR3R b kq - 1 1",
+		},
+		{
+			name:        "Queenside castle with the king on the rook's destination.",
+			fen:         "r2k3r/8/8/8/8/8/8/r2K3R b Kkq - 0 1",
+			san:         "O-O-O",
+			expectedFEN: "2kr3r/8/8/8/8/8/8/r2K3R w K - 1 2",
+		},
+		{
+			name:      "Obstructed castle.",
+			fen:       "1r4kr/8/8/8/8/8/8/1R2N1KR w KQkq - 0 1",
+			san:       "O-O-O",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chess960Board, err := (&RequestNewChess960Board{FEN: tc.fen}).PerformAction()
+			assert.Nil(t, err)
+
+			move, err := chess960Board.ParseSAN(tc.san)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Nil(t, chess960Board.HandleMove(move))
+			assert.Equal(t, tc.expectedFEN, chess960Board.FEN())
+		})
+	}
+}
@@ -4,85 +4,112 @@ import (
 	"github.com/variant64/server/pkg/models/board"
 )
 
-// NewClassicBoard creates a new Board with classic rules and returns it.
-func NewClassicBoard() *board.Board {
-	bounds := board.Bounds{RankCount: 8, FileCount: 8}
-	castlingState := board.NewDefaultCastlingState()
+// classicBounds are the Bounds of the standard 8x8 board.
+var classicBounds = board.Bounds{RankCount: 8, FileCount: 8}
+
+func init() {
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeClassic,
+		Description: "Standard chess.",
+		Bounds:      classicBounds,
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return (&RequestNewClassicBoard{FEN: options.FEN}).PerformAction()
+		},
+	})
+}
+
+// NewClassicBoard creates a new Board with classic rules and returns it,
+// the options are applied after the classic setup.
+func NewClassicBoard(options ...board.BuilderOption) *board.Board {
+	bounds := classicBounds
+	castlingState := board.NewCastlingState(board.NewDefaultCastlingRules(bounds)...)
+
+	classicOptions := append(
+		classicRules(
+			bounds,
+			castlingState,
+			board.NewDefaultDoublePushRanks(bounds),
+			board.DefaultPromotionPieceTypes(),
+			nil,
+		),
+		board.WithGameboardState(classicStartingState(bounds)),
+	)
+
+	return board.Build(append(classicOptions, options...)...)
+}
+
+// classicStartingState returns the classic starting GameboardState with pieces moving on the provided Bounds.
+func classicStartingState(bounds board.Bounds) board.GameboardState {
+	return board.GameboardState{
+		7: {
+			0: board.NewRook(board.BLACK, bounds),
+			1: board.NewKnight(board.BLACK),
+			2: board.NewBishop(board.BLACK, bounds),
+			3: board.NewQueen(board.BLACK, bounds),
+			4: board.NewKing(board.BLACK),
+			5: board.NewBishop(board.BLACK, bounds),
+			6: board.NewKnight(board.BLACK),
+			7: board.NewRook(board.BLACK, bounds),
+		},
+		6: {
+			0: board.NewPawn(board.BLACK),
+			1: board.NewPawn(board.BLACK),
+			2: board.NewPawn(board.BLACK),
+			3: board.NewPawn(board.BLACK),
+			4: board.NewPawn(board.BLACK),
+			5: board.NewPawn(board.BLACK),
+			6: board.NewPawn(board.BLACK),
+			7: board.NewPawn(board.BLACK),
+		},
+		1: {
+			0: board.NewPawn(board.WHITE),
+			1: board.NewPawn(board.WHITE),
+			2: board.NewPawn(board.WHITE),
+			3: board.NewPawn(board.WHITE),
+			4: board.NewPawn(board.WHITE),
+			5: board.NewPawn(board.WHITE),
+			6: board.NewPawn(board.WHITE),
+			7: board.NewPawn(board.WHITE),
+		},
+		0: {
+			0: board.NewRook(board.WHITE, bounds),
+			1: board.NewKnight(board.WHITE),
+			2: board.NewBishop(board.WHITE, bounds),
+			3: board.NewQueen(board.WHITE, bounds),
+			4: board.NewKing(board.WHITE),
+			5: board.NewBishop(board.WHITE, bounds),
+			6: board.NewKnight(board.WHITE),
+			7: board.NewRook(board.WHITE, bounds),
+		},
+	}
+}
+
+// classicRules returns the BuilderOptions of the classic rules on the provided Bounds,
+// castling follows the provided CastlingState, pawns double push from the provided DoublePushRanks
+// and promote to the provided PieceTypes which move following the PieceMovements.
+func classicRules(
+	bounds board.Bounds,
+	castlingState *board.CastlingState,
+	doublePushRanks board.DoublePushRanks,
+	promotionPieceTypes map[board.PieceType]bool,
+	pieceMovements board.PieceMovements,
+) []board.BuilderOption {
+	enPassantState := board.NewDefaultEnPassantState()
 	turnState := &board.TurnState{
 		Active:    board.WHITE,
 		TurnOrder: []board.Color{board.BLACK, board.WHITE},
 	}
 
-	return board.Build(
+	return []board.BuilderOption{
 		board.WithBounds(bounds),
 		board.WithCastlingState(castlingState),
+		board.WithEnPassantState(enPassantState),
 		board.WithMoveApplicator(
-			board.NewMoveApplicator(
-				&board.SinglePieceMoveApplicator{},
-				&board.KingsideCastleMoveApplicator{},
-				&board.QueensideCastleMoveApplicator{},
-				&board.PromotionMoveApplicator{Bounds: bounds},
-			),
+			classicMoveApplicator(bounds, castlingState, enPassantState, pieceMovements),
 		),
 		board.WithMoveFilter(
-			board.NewMoveFilter(
-				&board.FilterOutOfBounds{Bounds: bounds},
-				&board.FilterPieceCollision{},
-				&board.FilterFriendlyCapture{},
-				&board.FilterInvalidPawnDoublePush{},
-				&board.FilterIllegalKingsideCastle{
-					CastlingState: castlingState,
-				},
-				&board.FilterIllegalQueensideCastle{
-					CastlingState: castlingState,
-				},
-				&board.FilterIllegalPromotion{},
-				&board.FilterIllegalPromotionCapture{},
-			),
-		),
-		board.WithGameboardState(
-			board.GameboardState{
-				7: {
-					0: board.NewRook(board.BLACK, bounds),
-					1: board.NewKnight(board.BLACK),
-					2: board.NewBishop(board.BLACK, bounds),
-					3: board.NewQueen(board.BLACK, bounds),
-					4: board.NewKing(board.BLACK),
-					5: board.NewBishop(board.BLACK, bounds),
-					6: board.NewKnight(board.BLACK),
-					7: board.NewRook(board.BLACK, bounds),
-				},
-				6: {
-					0: board.NewPawn(board.BLACK),
-					1: board.NewPawn(board.BLACK),
-					2: board.NewPawn(board.BLACK),
-					3: board.NewPawn(board.BLACK),
-					4: board.NewPawn(board.BLACK),
-					5: board.NewPawn(board.BLACK),
-					6: board.NewPawn(board.BLACK),
-					7: board.NewPawn(board.BLACK),
-				},
-				1: {
-					0: board.NewPawn(board.WHITE),
-					1: board.NewPawn(board.WHITE),
-					2: board.NewPawn(board.WHITE),
-					3: board.NewPawn(board.WHITE),
-					4: board.NewPawn(board.WHITE),
-					5: board.NewPawn(board.WHITE),
-					6: board.NewPawn(board.WHITE),
-					7: board.NewPawn(board.WHITE),
-				},
-				0: {
-					0: board.NewRook(board.WHITE, bounds),
-					1: board.NewKnight(board.WHITE),
-					2: board.NewBishop(board.WHITE, bounds),
-					3: board.NewQueen(board.WHITE, bounds),
-					4: board.NewKing(board.WHITE),
-					5: board.NewBishop(board.WHITE, bounds),
-					6: board.NewKnight(board.WHITE),
-					7: board.NewRook(board.WHITE, bounds),
-				},
-			},
+			classicMoveFilter(bounds, castlingState, enPassantState, doublePushRanks, promotionPieceTypes),
 		),
 		board.WithIllegalStateFilter(
 			board.NewIllegalStateFilter(
@@ -91,6 +118,71 @@ func NewClassicBoard() *board.Board {
 				},
 			),
 		),
+		board.WithInsufficientMaterialRule(
+			board.NewInsufficientMaterialRule(
+				&board.ClassicInsufficientMaterialRule{},
+			),
+		),
 		board.WithTurnState(turnState),
+	}
+}
+
+// classicMoveApplicator returns the MoveApplicator of the classic moves,
+// promoted pieces move following the PieceMovements.
+func classicMoveApplicator(
+	bounds board.Bounds,
+	castlingState *board.CastlingState,
+	enPassantState *board.EnPassantState,
+	pieceMovements board.PieceMovements,
+) *board.MoveApplicator {
+	return board.NewMoveApplicator(
+		&board.SinglePieceMoveApplicator{},
+		&board.KingsideCastleMoveApplicator{CastlingState: castlingState},
+		&board.QueensideCastleMoveApplicator{CastlingState: castlingState},
+		&board.PromotionMoveApplicator{
+			Bounds:         bounds,
+			PieceMovements: pieceMovements,
+		},
+		&board.EnPassantMoveApplicator{EnPassantState: enPassantState},
+	)
+}
+
+// classicMoveFilter returns the MoveFilter of the classic moves on the provided Bounds.
+func classicMoveFilter(
+	bounds board.Bounds,
+	castlingState *board.CastlingState,
+	enPassantState *board.EnPassantState,
+	doublePushRanks board.DoublePushRanks,
+	promotionPieceTypes map[board.PieceType]bool,
+) *board.MoveFilter {
+	promotionRanks := board.NewDefaultPromotionRanks(bounds)
+	return board.NewMoveFilter(
+		&board.FilterOutOfBounds{Bounds: bounds},
+		&board.FilterPieceCollision{Bounds: bounds},
+		&board.FilterFriendlyCapture{},
+		&board.FilterInvalidPawnDoublePush{
+			DoublePushRanks: doublePushRanks,
+		},
+		&board.FilterIllegalEnPassant{
+			EnPassantState: enPassantState,
+		},
+		&board.FilterIllegalKingsideCastle{
+			CastlingState: castlingState,
+		},
+		&board.FilterIllegalQueensideCastle{
+			CastlingState: castlingState,
+		},
+		&board.FilterIllegalPromotion{
+			PromotionRanks: promotionRanks,
+		},
+		&board.FilterIllegalPromotionCapture{
+			PromotionRanks: promotionRanks,
+		},
+		&board.FilterMissingPromotion{
+			PromotionRanks: promotionRanks,
+		},
+		&board.FilterIllegalPromotionPieceType{
+			PieceTypes: promotionPieceTypes,
+		},
 	)
 }
@@ -0,0 +1,77 @@
+package variants
+
+import (
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func init() {
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeCrazyhouse,
+		Description: "Standard chess where captured pieces join the capturer's reserve and can be dropped back onto the board.",
+		Bounds:      classicBounds,
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return (&RequestNewCrazyhouseBoard{FEN: options.FEN}).PerformAction()
+		},
+	})
+}
+
+// NewCrazyhouseBoard creates a new Board with crazyhouse rules and returns it,
+// the options are applied after the crazyhouse setup.
+// A captured piece joins the capturer's reserve, promoted pieces as pawns,
+// and dropping a piece from the reserve onto an empty square is a move.
+func NewCrazyhouseBoard(options ...board.BuilderOption) *board.Board {
+	bounds := classicBounds
+	return NewClassicBoard(append(crazyhouseRules(bounds), options...)...)
+}
+
+// crazyhouseRules returns the BuilderOptions adding reserves and drops to the classic rules on the provided Bounds.
+func crazyhouseRules(bounds board.Bounds) []board.BuilderOption {
+	castlingState := board.NewCastlingState(board.NewDefaultCastlingRules(bounds)...)
+	enPassantState := board.NewDefaultEnPassantState()
+	turnState := &board.TurnState{
+		Active:    board.WHITE,
+		TurnOrder: []board.Color{board.BLACK, board.WHITE},
+	}
+
+	return []board.BuilderOption{
+		board.WithCastlingState(castlingState),
+		board.WithEnPassantState(enPassantState),
+		board.WithReserveState(board.NewDefaultReserveState()),
+		board.WithMoveApplicator(
+			board.NewMoveApplicator(
+				&board.SinglePieceMoveApplicator{},
+				&board.KingsideCastleMoveApplicator{CastlingState: castlingState},
+				&board.QueensideCastleMoveApplicator{CastlingState: castlingState},
+				&board.PromotionMoveApplicator{Bounds: bounds},
+				&board.EnPassantMoveApplicator{EnPassantState: enPassantState},
+				&board.DropMoveApplicator{
+					Bounds:    bounds,
+					TurnState: turnState,
+				},
+			),
+		),
+		board.WithMoveFilter(
+			board.NewMoveFilter(
+				classicMoveFilter(
+					bounds,
+					castlingState,
+					enPassantState,
+					board.NewDefaultDoublePushRanks(bounds),
+					board.DefaultPromotionPieceTypes(),
+				),
+				&board.FilterIllegalDrop{Bounds: bounds},
+			),
+		),
+		board.WithIllegalStateFilter(
+			board.NewIllegalStateFilter(
+				&board.IllegalCheckStateFilter{
+					TurnState: turnState,
+				},
+			),
+		),
+		// Captured pieces are never lost, they can be dropped to mate.
+		board.WithInsufficientMaterialRule(nil),
+		board.WithTurnState(turnState),
+	}
+}
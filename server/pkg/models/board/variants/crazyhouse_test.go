@@ -0,0 +1,97 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func TestNewCrazyhouseBoard(t *testing.T) {
+	crazyhouseBoard := NewCrazyhouseBoard()
+	assert.Equal(t, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[] w KQkq - 0 1", crazyhouseBoard.FEN())
+}
+
+func TestCrazyhouseMoves(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		fen                  string
+		san                  string
+		expectErr            bool
+		expectedFEN          string
+		expectedGameEndState board.GameEndState
+	}{
+		{
+			name:        "Capture joins the reserve.",
+			fen:         "rnbqkbnr/ppp1pppp/8/3p4/4P3/8/PPPP1PPP/RNBQKBNR[] w KQkq d6 0 2",
+			san:         "exd5",
+			expectedFEN: "rnbqkbnr/ppp1pppp/8/3P4/8/8/PPPP1PPP/RNBQKBNR[P] b KQkq - 0 2",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+		},
+		{
+			name:        "Knight drop.",
+			fen:         "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKB1R[N] w KQkq - 0 1",
+			san:         "N@f3",
+			expectedFEN: "rnbqkbnr/pppppppp/8/8/8/5N2/PPPPPPPP/RNBQKB1R[] b KQkq - 1 1",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+		},
+		{
+			name:      "Pawn drop on the last rank not allowed.",
+			fen:       "4k3/8/8/8/8/8/8/4K3[P] w - - 0 1",
+			san:       "P@a8",
+			expectErr: true,
+		},
+		{
+			name:        "Captured promoted piece returns as a pawn.",
+			fen:         "3Q~k3/8/8/8/8/8/8/3qK3[] b - - 0 1",
+			san:         "Qxd8",
+			expectedFEN: "3qk3/8/8/8/8/8/8/4K3[p] w - - 0 2",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+		},
+		{
+			name:        "Checkmate by a drop.",
+			fen:         "6k1/5ppp/8/8/8/8/8/4K3[R] w - - 0 1",
+			san:         "R@a8#",
+			expectedFEN: "R5k1/5ppp/8/8/8/8/8/4K3[] b - - 1 1",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateCheckmate,
+				Winner:       board.WHITE,
+				Loser:        board.BLACK,
+			},
+		},
+		{
+			name:        "Captured pieces are never insufficient material.",
+			fen:         "4k3/8/8/8/8/8/3r4/2B1K3[] w - - 0 1",
+			san:         "Bxd2",
+			expectedFEN: "4k3/8/8/8/8/8/3B4/4K3[R] b - - 0 1",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			crazyhouseBoard, err := (&RequestNewCrazyhouseBoard{FEN: tc.fen}).PerformAction()
+			assert.Nil(t, err)
+
+			move, err := crazyhouseBoard.ParseSAN(tc.san)
+			if tc.expectErr {
+				if err == nil {
+					assert.NotNil(t, crazyhouseBoard.HandleMove(move))
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Nil(t, crazyhouseBoard.HandleMove(move))
+			assert.Equal(t, tc.expectedFEN, crazyhouseBoard.FEN())
+			assert.Equal(t, tc.expectedGameEndState, crazyhouseBoard.GetGameEndState())
+		})
+	}
+}
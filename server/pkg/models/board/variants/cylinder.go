@@ -0,0 +1,42 @@
+package variants
+
+import (
+	"github.com/variant64/server/pkg/models/board"
+)
+
+// cylinderBounds are the Bounds of the 8x8 board whose files wrap around.
+var cylinderBounds = board.Bounds{RankCount: 8, FileCount: 8, Topology: board.TopologyCylinder}
+
+func init() {
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeCylinder,
+		Description: "Standard chess on a board whose a-file and h-file are joined, pieces moving off one side re-enter on the other.",
+		Bounds:      cylinderBounds,
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return (&RequestNewCylinderBoard{FEN: options.FEN}).PerformAction()
+		},
+	})
+}
+
+// NewCylinderBoard creates a new Board with cylinder chess rules and returns it,
+// the options are applied after the cylinder chess setup.
+// The pieces start as in classic chess, the files wrap around so rays, leaps and pawn captures
+// continue from the h-file onto the a-file and back.
+func NewCylinderBoard(options ...board.BuilderOption) *board.Board {
+	bounds := cylinderBounds
+	castlingState := board.NewCastlingState(board.NewDefaultCastlingRules(bounds)...)
+
+	cylinderOptions := append(
+		classicRules(
+			bounds,
+			castlingState,
+			board.NewDefaultDoublePushRanks(bounds),
+			board.DefaultPromotionPieceTypes(),
+			nil,
+		),
+		board.WithGameboardState(classicStartingState(bounds)),
+	)
+
+	return board.Build(append(cylinderOptions, options...)...)
+}
@@ -0,0 +1,59 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func TestNewCylinderBoard(t *testing.T) {
+	cylinderBoard := NewCylinderBoard()
+	assert.Equal(t, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", cylinderBoard.FEN())
+	assert.Equal(t, board.Topology(board.TopologyCylinder), cylinderBoard.GetBounds().Topology)
+}
+
+func TestCylinderMoves(t *testing.T) {
+	testCases := []struct {
+		name        string
+		fen         string
+		san         string
+		expectErr   bool
+		expectedFEN string
+	}{
+		{
+			name:        "Rook moves from the h-file onto the a-file.",
+			fen:         "4k3/8/8/8/8/8/8/4K2R w - - 0 1",
+			san:         "Ra1",
+			expectedFEN: "4k3/8/8/8/8/8/8/R3K3 b - - 1 1",
+		},
+		{
+			name:        "Pawn captures across the edge.",
+			fen:         "4k3/8/8/8/8/7p/P7/4K3 w - - 0 1",
+			san:         "axh3",
+			expectedFEN: "4k3/8/8/8/8/7P/8/4K3 b - - 0 1",
+		},
+		{
+			name:      "Rook blocked both ways around the board.",
+			fen:       "4k3/8/8/8/8/8/8/P3K2R w - - 0 1",
+			san:       "Rb1",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cylinderBoard, err := (&RequestNewCylinderBoard{FEN: tc.fen}).PerformAction()
+			assert.Nil(t, err)
+
+			move, err := cylinderBoard.ParseSAN(tc.san)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Nil(t, cylinderBoard.HandleMove(move))
+			assert.Equal(t, tc.expectedFEN, cylinderBoard.FEN())
+		})
+	}
+}
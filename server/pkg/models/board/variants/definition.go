@@ -0,0 +1,276 @@
+package variants
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/variant64/server/pkg/models/board"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// WinConditionCheckmate wins the game by checkmating the opponent's king.
+	WinConditionCheckmate = "checkmate"
+	// WinConditionKingOfTheHill wins the game by moving the king to one of the centre squares.
+	WinConditionKingOfTheHill = "king_of_the_hill"
+	// WinConditionThreeCheck wins the game by giving check three times.
+	WinConditionThreeCheck = "three_check"
+)
+
+// definitionWinConditions are the supported win conditions of VariantDefinitions.
+var definitionWinConditions = map[string]bool{
+	WinConditionCheckmate:     true,
+	WinConditionKingOfTheHill: true,
+	WinConditionThreeCheck:    true,
+}
+
+// definitionExtensions are the file extensions of VariantDefinition files.
+var definitionExtensions = map[string]bool{
+	".json": true,
+	".yaml": true,
+	".yml":  true,
+}
+
+// VariantDefinition declares a Variant with classic rules without writing Go, it's loaded from JSON or YAML.
+type VariantDefinition struct {
+	Name        board.GameboardType `json:"name"`
+	Description string              `json:"description"`
+	Bounds      board.Bounds        `json:"bounds"`
+	// FairyPieces declares new piece types, they can be used by the other fields once declared.
+	FairyPieces []PieceDefinition `json:"fairy_pieces"`
+	// Pieces maps piece types to their movement in Betza notation, e.g. "archbishop": "BN",
+	// piece types without a movement move as they do in classic chess.
+	Pieces map[string]string `json:"pieces"`
+	// FEN is the starting position.
+	FEN string `json:"fen"`
+	// Promotion lists the piece types pawns can be promoted to, classic chess' if empty.
+	Promotion []string `json:"promotion"`
+	// Castling lists the castling rules of both players, the kings don't castle if empty.
+	Castling []board.CastlingRule `json:"castling"`
+	// DoublePush allows pawns to double push from their second rank.
+	DoublePush bool `json:"double_push"`
+	// WinConditions lists how the game is won, checkmate always wins the game.
+	WinConditions []string `json:"win_conditions"`
+}
+
+// PieceDefinition declares a fairy piece type of a VariantDefinition.
+type PieceDefinition struct {
+	// Name is the name of the piece type, e.g. "amazon".
+	Name string `json:"name"`
+	// Letter represents the piece in FEN and SAN, e.g. "M".
+	Letter string `json:"letter"`
+	// Movement is the movement of the piece in Betza notation, e.g. "QN".
+	Movement string `json:"movement"`
+}
+
+// ParseVariantDefinition parses and validates a VariantDefinition in JSON or YAML.
+func ParseVariantDefinition(data []byte) (*VariantDefinition, error) {
+	// YAML is a superset of JSON, both are decoded through the JSON field names.
+	var document interface{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, errInvalidVariantDefinition("", err.Error())
+	}
+	jsonData, err := json.Marshal(document)
+	if err != nil {
+		return nil, errInvalidVariantDefinition("", err.Error())
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+	definition := &VariantDefinition{}
+	if err := decoder.Decode(definition); err != nil {
+		return nil, errInvalidVariantDefinition("", err.Error())
+	}
+
+	if _, err := definition.Variant(); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
+
+// Variant returns the Variant declared by the VariantDefinition.
+func (d *VariantDefinition) Variant() (Variant, error) {
+	if d.Name == board.GameboardTypeDefault {
+		return Variant{}, errInvalidVariantDefinition(d.Name, "missing name")
+	}
+	if d.Bounds.RankCount <= 0 || d.Bounds.FileCount <= 0 {
+		return Variant{}, errInvalidVariantDefinition(d.Name, "invalid bounds")
+	}
+	for _, void := range d.Bounds.Voids {
+		if void.Rank < 0 || void.Rank >= d.Bounds.RankCount || void.File < 0 || void.File >= d.Bounds.FileCount {
+			return Variant{}, errInvalidVariantDefinition(d.Name, "invalid voids")
+		}
+	}
+
+	for _, piece := range d.FairyPieces {
+		letter := []rune(piece.Letter)
+		if len(letter) != 1 {
+			return Variant{}, errInvalidVariantDefinition(d.Name, "invalid letter of "+piece.Name)
+		}
+		if _, err := board.RegisterPieceType(piece.Name, letter[0], piece.Movement); err != nil {
+			return Variant{}, errInvalidVariantDefinition(d.Name, err.Error())
+		}
+	}
+
+	pieceMovements := board.PieceMovements{}
+	for name, notation := range d.Pieces {
+		pieceType, err := board.ParsePieceType(name)
+		if err != nil {
+			return Variant{}, errInvalidVariantDefinition(d.Name, err.Error())
+		}
+		pieceMovements[pieceType] = notation
+	}
+	if err := pieceMovements.Validate(); err != nil {
+		return Variant{}, errInvalidVariantDefinition(d.Name, err.Error())
+	}
+
+	promotionPieceTypes := board.DefaultPromotionPieceTypes()
+	if len(d.Promotion) > 0 {
+		promotionPieceTypes = map[board.PieceType]bool{}
+		for _, name := range d.Promotion {
+			pieceType, err := board.ParsePieceType(name)
+			if err != nil {
+				return Variant{}, errInvalidVariantDefinition(d.Name, err.Error())
+			}
+			promotionPieceTypes[pieceType] = true
+		}
+	}
+
+	for _, rule := range d.Castling {
+		if err := d.validateCastlingRule(rule); err != nil {
+			return Variant{}, err
+		}
+	}
+
+	for _, winCondition := range d.WinConditions {
+		if !definitionWinConditions[winCondition] {
+			return Variant{}, errInvalidVariantDefinition(d.Name, "unsupported win condition "+winCondition)
+		}
+	}
+
+	newBoard := func(options BoardOptions) (*board.Board, error) {
+		return d.newBoard(options, pieceMovements, promotionPieceTypes)
+	}
+	if _, err := newBoard(BoardOptions{}); err != nil {
+		return Variant{}, errInvalidVariantDefinition(d.Name, err.Error())
+	}
+
+	return Variant{
+		Name:        d.Name,
+		Description: d.Description,
+		Bounds:      d.Bounds,
+		PlayerCount: 2,
+		NewBoard:    newBoard,
+	}, nil
+}
+
+// newBoard creates a new Board of the VariantDefinition,
+// starting from the FEN position of the options if one is provided.
+func (d *VariantDefinition) newBoard(
+	options BoardOptions,
+	pieceMovements board.PieceMovements,
+	promotionPieceTypes map[board.PieceType]bool,
+) (*board.Board, error) {
+	fen := d.FEN
+	if options.FEN != "" {
+		fen = options.FEN
+	}
+	position, err := board.ParseFEN(fen, d.Bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	// Replace the parsed pieces with pieces following the declared movements.
+	if err := pieceMovements.ReplacePieces(position.GameboardState, d.Bounds); err != nil {
+		return nil, err
+	}
+
+	castlingState := board.NewCastlingState(d.Castling...)
+	doublePushRanks := board.DoublePushRanks{}
+	if d.DoublePush {
+		doublePushRanks = board.NewDefaultDoublePushRanks(d.Bounds)
+	}
+
+	definitionOptions := classicRules(d.Bounds, castlingState, doublePushRanks, promotionPieceTypes, pieceMovements)
+	winCondition := board.NewWinCondition()
+	hasWinCondition := false
+	for _, name := range d.WinConditions {
+		switch name {
+		case WinConditionKingOfTheHill:
+			winCondition.AddWinCondition(board.NewKingOfTheHillWinCondition(d.Bounds))
+			hasWinCondition = true
+		case WinConditionThreeCheck:
+			checkCountState := board.NewDefaultCheckCountState()
+			winCondition.AddWinCondition(board.NewThreeCheckWinCondition(checkCountState))
+			definitionOptions = append(definitionOptions, board.WithCheckCountState(checkCountState))
+			hasWinCondition = true
+		}
+	}
+	if hasWinCondition {
+		// Material that can't mate may still win by the other win conditions.
+		definitionOptions = append(
+			definitionOptions,
+			board.WithWinCondition(winCondition),
+			board.WithInsufficientMaterialRule(nil),
+		)
+	}
+	definitionOptions = append(definitionOptions, board.WithFEN(position))
+	return board.Build(definitionOptions...), nil
+}
+
+// validateCastlingRule returns an error if the CastlingRule isn't a castle of either player within the Bounds.
+func (d *VariantDefinition) validateCastlingRule(rule board.CastlingRule) error {
+	if rule.MoveType != board.KINGSIDE_CASTLE && rule.MoveType != board.QUEENSIDE_CASTLE {
+		return errInvalidVariantDefinition(d.Name, "invalid castling move type "+rule.MoveType.String())
+	}
+	if rule.Color != board.WHITE && rule.Color != board.BLACK {
+		return errInvalidVariantDefinition(d.Name, "invalid castling color "+rule.Color.String())
+	}
+
+	positions := []board.Position{rule.KingSource, rule.KingDestination, rule.RookSource, rule.RookDestination}
+	positions = append(positions, rule.EmptySquares...)
+	positions = append(positions, rule.SafeSquares...)
+	for _, position := range positions {
+		if !d.Bounds.IsInboundsPosition(position) {
+			return errInvalidVariantDefinition(d.Name, "castling square out of bounds")
+		}
+	}
+	return nil
+}
+
+// LoadVariantDefinitions registers the Variants declared by the JSON and YAML files in the directory,
+// a Variant can't replace one that is already registered.
+func LoadVariantDefinitions(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !definitionExtensions[strings.ToLower(filepath.Ext(entry.Name()))] {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		definition, err := ParseVariantDefinition(data)
+		if err != nil {
+			return errInvalidVariantDefinitionFile(entry.Name(), err)
+		}
+		if _, err := GetVariant(definition.Name); err == nil {
+			return errInvalidVariantDefinitionFile(
+				entry.Name(),
+				errInvalidVariantDefinition(definition.Name, "variant already registered"),
+			)
+		}
+
+		variant, _ := definition.Variant()
+		RegisterVariant(variant)
+	}
+	return nil
+}
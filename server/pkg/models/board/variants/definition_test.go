@@ -0,0 +1,296 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func TestParseVariantDefinition(t *testing.T) {
+	testCases := []struct {
+		name      string
+		data      string
+		expectErr bool
+	}{
+		{
+			name: "YAML definition.",
+			data: `
+name: tiny
+bounds: {rank: 4, file: 4}
+pieces: {knight: N}
+fen: k3/p3/3P/3K w - - 0 1
+`,
+		},
+		{
+			name: "JSON definition.",
+			data: `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1"}`,
+		},
+		{
+			name:      "Unparseable definition.",
+			data:      `{"name": "tiny"`,
+			expectErr: true,
+		},
+		{
+			name:      "Unknown field.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1", "gravity": true}`,
+			expectErr: true,
+		},
+		{
+			name:      "Missing name.",
+			data:      `{"bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1"}`,
+			expectErr: true,
+		},
+		{
+			name:      "Missing bounds.",
+			data:      `{"name": "tiny", "fen": "k3/p3/3P/3K w - - 0 1"}`,
+			expectErr: true,
+		},
+		{
+			name:      "Invalid FEN.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3K w - - 0 1"}`,
+			expectErr: true,
+		},
+		{
+			name:      "Unknown piece type.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1", "pieces": {"dragon": "BN"}}`,
+			expectErr: true,
+		},
+		{
+			name:      "Invalid Betza notation.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1", "pieces": {"knight": "Nz"}}`,
+			expectErr: true,
+		},
+		{
+			name:      "Unknown promotion piece type.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1", "promotion": ["dragon"]}`,
+			expectErr: true,
+		},
+		{
+			name: "Fairy piece definition.",
+			data: `
+name: tiny
+bounds: {rank: 4, file: 4}
+fairy_pieces: [{name: wazir, letter: W, movement: W}]
+promotion: [wazir]
+fen: k3/p3/3P/2WK w - - 0 1
+`,
+		},
+		{
+			name: "Castling rule definition.",
+			data: `
+name: tiny
+bounds: {rank: 4, file: 4}
+castling:
+  - move_type: kingside_castle
+    color: white
+    king_source: {rank: 0, file: 1}
+    king_destination: {rank: 0, file: 2}
+    rook_source: {rank: 0, file: 3}
+    rook_destination: {rank: 0, file: 1}
+fen: k3/p3/3P/1K1R w K - 0 1
+`,
+		},
+		{
+			name:      "Fairy piece letter used by a built-in piece.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1", "fairy_pieces": [{"name": "dragon", "letter": "Q", "movement": "QN"}]}`,
+			expectErr: true,
+		},
+		{
+			name:      "Fairy piece without a letter.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1", "fairy_pieces": [{"name": "dragon", "movement": "QN"}]}`,
+			expectErr: true,
+		},
+		{
+			name:      "Fairy piece with invalid Betza notation.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1", "fairy_pieces": [{"name": "dragon", "letter": "D", "movement": "Dz"}]}`,
+			expectErr: true,
+		},
+		{
+			name:      "Castling rule without a move type.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1", "castling": [{"color": "white"}]}`,
+			expectErr: true,
+		},
+		{
+			name:      "Castling rule out of bounds.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1", "castling": [{"move_type": "kingside_castle", "color": "white", "rook_source": {"rank": 0, "file": 7}}]}`,
+			expectErr: true,
+		},
+		{
+			name:      "Castling rule with an invalid color.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1", "castling": [{"move_type": "kingside_castle", "color": "green"}]}`,
+			expectErr: true,
+		},
+		{
+			name: "King of the hill win condition.",
+			data: `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1", "win_conditions": ["checkmate", "king_of_the_hill"]}`,
+		},
+		{
+			name: "Voids.",
+			data: `{"name": "tiny", "bounds": {"rank": 4, "file": 4, "voids": [{"rank": 1, "file": 1}, {"rank": 2, "file": 2}]}, "fen": "k3/p1*1/1*1P/3K w - - 0 1"}`,
+		},
+		{
+			name:      "Void outside the bounds.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4, "voids": [{"rank": 4, "file": 1}]}, "fen": "k3/p3/3P/3K w - - 0 1"}`,
+			expectErr: true,
+		},
+		{
+			name:      "Piece on a void.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4, "voids": [{"rank": 0, "file": 3}]}, "fen": "k3/p3/3P/3K w - - 0 1"}`,
+			expectErr: true,
+		},
+		{
+			name:      "Unsupported win condition.",
+			data:      `{"name": "tiny", "bounds": {"rank": 4, "file": 4}, "fen": "k3/p3/3P/3K w - - 0 1", "win_conditions": ["bare_king"]}`,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			definition, err := ParseVariantDefinition([]byte(tc.data))
+			if tc.expectErr {
+				assert.Nil(t, definition)
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, board.GameboardType("tiny"), definition.Name)
+		})
+	}
+}
+
+func TestLoadVariantDefinitions(t *testing.T) {
+	names := []board.GameboardType{"amazon_chess", "mini_archbishop", "knight_rooks"}
+	defer func() {
+		variantRegistry.mux.Lock()
+		defer variantRegistry.mux.Unlock()
+		for _, name := range names {
+			delete(variantRegistry.variants, name)
+		}
+	}()
+
+	assert.Nil(t, LoadVariantDefinitions("testdata/definitions"))
+	// Loaded variants can't be replaced.
+	assert.NotNil(t, LoadVariantDefinitions("testdata/definitions"))
+
+	testCases := []struct {
+		name          string
+		gameboardType board.GameboardType
+		options       BoardOptions
+		san           string
+		expectedFEN   string
+	}{
+		{
+			name:          "Archbishop moves as a knight.",
+			gameboardType: "mini_archbishop",
+			san:           "Ad3",
+			expectedFEN:   "rnqkar/pppppp/6/3A2/PPPPPP/RNQK1R b - - 1 1",
+		},
+		{
+			name:          "Knight moves as a wazir.",
+			gameboardType: "mini_archbishop",
+			options:       BoardOptions{FEN: "3k2/6/6/6/1N4/3K2 w - - 0 1"},
+			san:           "Nb3",
+			expectedFEN:   "3k2/6/6/1N4/6/3K2 b - - 1 1",
+		},
+		{
+			name:          "Promotion to archbishop.",
+			gameboardType: "mini_archbishop",
+			options:       BoardOptions{FEN: "3k2/P5/6/6/6/3K2 w - - 0 1"},
+			san:           "a6=A",
+			expectedFEN:   "A2k2/6/6/6/6/3K2 b - - 0 1",
+		},
+		{
+			name:          "Rook moves as a knight.",
+			gameboardType: "knight_rooks",
+			san:           "Rb3",
+			expectedFEN:   "rnbqkbnr/pppppppp/8/8/8/1R6/PPPPPPPP/1NBQKBNR b Kkq - 1 1",
+		},
+		{
+			name:          "Amazon moves as a knight.",
+			gameboardType: "amazon_chess",
+			options:       BoardOptions{FEN: "4k3/8/8/8/8/8/8/3MK3 w - - 0 1"},
+			san:           "Mc3",
+			expectedFEN:   "4k3/8/8/8/8/2M5/8/4K3 b - - 1 1",
+		},
+		{
+			name:          "Promotion to amazon.",
+			gameboardType: "amazon_chess",
+			options:       BoardOptions{FEN: "4k3/P7/8/8/8/8/8/4K3 w - - 0 1"},
+			san:           "a8=M",
+			expectedFEN:   "M3k3/8/8/8/8/8/8/4K3 b - - 0 1",
+		},
+		{
+			name:          "Kingside castle.",
+			gameboardType: "knight_rooks",
+			options:       BoardOptions{FEN: "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1"},
+			san:           "O-O",
+			expectedFEN:   "r3k2r/8/8/8/8/8/8/R4RK1 b kq - 1 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			variant, err := GetVariant(tc.gameboardType)
+			assert.Nil(t, err)
+
+			variantBoard, err := variant.NewBoard(tc.options)
+			assert.Nil(t, err)
+
+			move, err := variantBoard.ParseSAN(tc.san)
+			assert.Nil(t, err)
+			assert.Nil(t, variantBoard.HandleMove(move))
+			assert.Equal(t, tc.expectedFEN, variantBoard.FEN())
+		})
+	}
+}
+
+func TestVariantDefinitionWinConditions(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		winConditions        []string
+		expectedEndStateType board.EndStateType
+	}{
+		{
+			name:                 "Checkmate only.",
+			winConditions:        []string{WinConditionCheckmate},
+			expectedEndStateType: board.EndStateInsufficientMaterial,
+		},
+		{
+			name:                 "King of the hill.",
+			winConditions:        []string{WinConditionCheckmate, WinConditionKingOfTheHill},
+			expectedEndStateType: board.EndStateKingOfTheHill,
+		},
+		{
+			name:                 "King of the hill and three-check.",
+			winConditions:        []string{WinConditionThreeCheck, WinConditionKingOfTheHill},
+			expectedEndStateType: board.EndStateKingOfTheHill,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			definition := &VariantDefinition{
+				Name:          "tiny",
+				Bounds:        board.Bounds{RankCount: 4, FileCount: 4},
+				FEN:           "k3/4/4/3K w - - 0 1",
+				WinConditions: tc.winConditions,
+			}
+			variant, err := definition.Variant()
+			assert.Nil(t, err)
+
+			variantBoard, err := variant.NewBoard(BoardOptions{})
+			assert.Nil(t, err)
+
+			move, err := variantBoard.ParseSAN("Kc2")
+			assert.Nil(t, err)
+			assert.Nil(t, variantBoard.HandleMove(move))
+			assert.Equal(t, tc.expectedEndStateType, variantBoard.GetGameEndState().EndStateType)
+		})
+	}
+}
+
+func TestLoadVariantDefinitionsMissingDirectory(t *testing.T) {
+	assert.NotNil(t, LoadVariantDefinitions("testdata/missing"))
+}
@@ -0,0 +1,29 @@
+package variants
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/variant64/server/pkg/errortypes"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+var errInvalidChess960PositionID = func(id int) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Variant error: invalid Chess960 position id %d", id))
+}
+
+var errFENNotSupported = func(name board.GameboardType) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Variant error: variant %q doesn't support FEN", name))
+}
+
+var errVariantNotFound = func(name board.GameboardType) errortypes.TypedError {
+	return errortypes.New(errortypes.NotFound, fmt.Sprintf("Variant error: variant %q not found", name))
+}
+
+var errInvalidVariantDefinition = func(name board.GameboardType, reason string) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Variant error: invalid definition %q: %s", name, reason))
+}
+
+var errInvalidVariantDefinitionFile = func(file string, error error) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, errors.Wrapf(error, "Variant error: invalid definition file %s ", file).Error())
+}
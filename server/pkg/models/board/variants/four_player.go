@@ -0,0 +1,140 @@
+package variants
+
+import (
+	"github.com/variant64/server/pkg/models/board"
+)
+
+// fourPlayerBounds are the Bounds of the 14x14 board with its 3x3 corners masked off.
+var fourPlayerBounds = board.NewCrossBounds(14, 3)
+
+func init() {
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeFourPlayer,
+		Description: "Free-for-all chess between four players on a cross-shaped board, checkmated and stalemated players are eliminated until one player is left.",
+		Bounds:      fourPlayerBounds,
+		PlayerCount: 4,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			if options.FEN != "" {
+				return nil, errFENNotSupported(board.GameboardTypeFourPlayer)
+			}
+			return (&RequestNewFourPlayerBoard{}).PerformAction()
+		},
+	})
+}
+
+// fourPlayerBackRanks maps each Color to its back rank PieceTypes from the a-file or first rank,
+// red and blue have the queen on the left of the king, yellow and green on the right.
+var fourPlayerBackRanks = map[board.Color][]board.PieceType{
+	board.RED:    {board.ROOK, board.KNIGHT, board.BISHOP, board.QUEEN, board.KING, board.BISHOP, board.KNIGHT, board.ROOK},
+	board.BLUE:   {board.ROOK, board.KNIGHT, board.BISHOP, board.QUEEN, board.KING, board.BISHOP, board.KNIGHT, board.ROOK},
+	board.YELLOW: {board.ROOK, board.KNIGHT, board.BISHOP, board.KING, board.QUEEN, board.BISHOP, board.KNIGHT, board.ROOK},
+	board.GREEN:  {board.ROOK, board.KNIGHT, board.BISHOP, board.KING, board.QUEEN, board.BISHOP, board.KNIGHT, board.ROOK},
+}
+
+// NewFourPlayerBoard creates a new Board with four-player rules and returns it,
+// the options are applied after the four-player setup.
+// Red starts on the first rank, blue on the a-file, yellow on the last rank and green on the last file,
+// playing in that order. Kings don't castle.
+func NewFourPlayerBoard(options ...board.BuilderOption) *board.Board {
+	bounds := fourPlayerBounds
+	fourPlayerOptions := append(
+		fourPlayerRules(bounds),
+		board.WithGameboardState(fourPlayerStartingState(bounds)),
+	)
+	return board.Build(append(fourPlayerOptions, options...)...)
+}
+
+// fourPlayerRules returns the BuilderOptions of the four-player rules on the provided Bounds.
+// Pawns promote on reaching the middle of the board, positions aren't counted for repetitions
+// and a player is eliminated instead of ending the game when they have no moves.
+func fourPlayerRules(bounds board.Bounds) []board.BuilderOption {
+	castlingState := board.NewCastlingState()
+	enPassantState := board.NewDefaultEnPassantState()
+	middle := bounds.RankCount / 2
+	promotionRanks := board.PromotionRanks{
+		board.RED:    middle,
+		board.BLUE:   middle,
+		board.YELLOW: middle - 1,
+		board.GREEN:  middle - 1,
+	}
+	doublePushRanks := board.DoublePushRanks{
+		board.RED:    1,
+		board.BLUE:   1,
+		board.YELLOW: bounds.RankCount - 2,
+		board.GREEN:  bounds.FileCount - 2,
+	}
+	turnState := &board.TurnState{
+		Active:    board.RED,
+		TurnOrder: []board.Color{board.BLUE, board.YELLOW, board.GREEN, board.RED},
+	}
+
+	return []board.BuilderOption{
+		board.WithBounds(bounds),
+		board.WithCastlingState(castlingState),
+		board.WithEnPassantState(enPassantState),
+		board.WithRepetitionState(nil),
+		board.WithEliminationState(board.NewDefaultEliminationState()),
+		board.WithMoveApplicator(
+			classicMoveApplicator(bounds, castlingState, enPassantState, nil),
+		),
+		board.WithMoveFilter(
+			board.NewMoveFilter(
+				&board.FilterOutOfBounds{Bounds: bounds},
+				&board.FilterPieceCollision{Bounds: bounds},
+				&board.FilterFriendlyCapture{},
+				&board.FilterInvalidPawnDoublePush{
+					DoublePushRanks: doublePushRanks,
+				},
+				&board.FilterIllegalEnPassant{
+					EnPassantState: enPassantState,
+				},
+				&board.FilterIllegalKingsideCastle{
+					CastlingState: castlingState,
+				},
+				&board.FilterIllegalQueensideCastle{
+					CastlingState: castlingState,
+				},
+				&board.FilterIllegalPromotion{
+					PromotionRanks: promotionRanks,
+				},
+				&board.FilterIllegalPromotionCapture{
+					PromotionRanks: promotionRanks,
+				},
+				&board.FilterMissingPromotion{
+					PromotionRanks: promotionRanks,
+				},
+				&board.FilterIllegalPromotionPieceType{
+					PieceTypes: board.DefaultPromotionPieceTypes(),
+				},
+			),
+		),
+		board.WithIllegalStateFilter(
+			board.NewIllegalStateFilter(
+				&board.IllegalCheckStateFilter{
+					TurnState: turnState,
+				},
+			),
+		),
+		board.WithInsufficientMaterialRule(nil),
+		board.WithTurnState(turnState),
+	}
+}
+
+// fourPlayerStartingState returns the starting GameboardState of four-player chess,
+// each army is set up in the middle of its side of the board.
+func fourPlayerStartingState(bounds board.Bounds) board.GameboardState {
+	last := bounds.RankCount - 1
+	state := board.NewGameboardState(bounds, board.GameboardState{})
+	for i := 0; i < 8; i++ {
+		square := i + 3
+		state[0][square], _ = board.NewPieceOfType(board.RED, fourPlayerBackRanks[board.RED][i], bounds)
+		state[1][square] = board.NewPawn(board.RED)
+		state[square][0], _ = board.NewPieceOfType(board.BLUE, fourPlayerBackRanks[board.BLUE][i], bounds)
+		state[square][1] = board.NewPawn(board.BLUE)
+		state[last][square], _ = board.NewPieceOfType(board.YELLOW, fourPlayerBackRanks[board.YELLOW][i], bounds)
+		state[last-1][square] = board.NewPawn(board.YELLOW)
+		state[square][last], _ = board.NewPieceOfType(board.GREEN, fourPlayerBackRanks[board.GREEN][i], bounds)
+		state[square][last-1] = board.NewPawn(board.GREEN)
+	}
+	return state
+}
@@ -0,0 +1,298 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func TestNewFourPlayerBoard(t *testing.T) {
+	fourPlayerBoard := NewFourPlayerBoard()
+
+	assert.Equal(t, board.Color(board.RED), fourPlayerBoard.GetActivePlayer())
+	assert.Equal(
+		t,
+		[]board.Color{board.RED, board.BLUE, board.YELLOW, board.GREEN},
+		fourPlayerBoard.GetColors(),
+	)
+	assert.Len(t, fourPlayerBoard.Voids, 36)
+	assert.False(t, fourPlayerBoard.IsFENSupported())
+
+	testCases := []struct {
+		name              string
+		position          board.Position
+		expectedColor     board.Color
+		expectedPieceType board.PieceType
+		expectedMoves     board.MoveMap
+	}{
+		{
+			name:              "Red king.",
+			position:          board.Position{Rank: 0, File: 7},
+			expectedColor:     board.RED,
+			expectedPieceType: board.KING,
+		},
+		{
+			name:              "Blue king.",
+			position:          board.Position{Rank: 7, File: 0},
+			expectedColor:     board.BLUE,
+			expectedPieceType: board.KING,
+		},
+		{
+			name:              "Yellow king.",
+			position:          board.Position{Rank: 13, File: 6},
+			expectedColor:     board.YELLOW,
+			expectedPieceType: board.KING,
+		},
+		{
+			name:              "Green king.",
+			position:          board.Position{Rank: 6, File: 13},
+			expectedColor:     board.GREEN,
+			expectedPieceType: board.KING,
+		},
+		{
+			name:              "Red pawn moves north.",
+			position:          board.Position{Rank: 1, File: 3},
+			expectedColor:     board.RED,
+			expectedPieceType: board.PAWN,
+			expectedMoves: board.MoveMap{
+				board.NORMAL:           {{Rank: 2, File: 3}},
+				board.PAWN_DOUBLE_PUSH: {{Rank: 3, File: 3}},
+			},
+		},
+		{
+			name:              "Blue pawn moves east.",
+			position:          board.Position{Rank: 3, File: 1},
+			expectedColor:     board.BLUE,
+			expectedPieceType: board.PAWN,
+			expectedMoves: board.MoveMap{
+				board.NORMAL:           {{Rank: 3, File: 2}},
+				board.PAWN_DOUBLE_PUSH: {{Rank: 3, File: 3}},
+			},
+		},
+		{
+			name:              "Yellow pawn moves south.",
+			position:          board.Position{Rank: 12, File: 10},
+			expectedColor:     board.YELLOW,
+			expectedPieceType: board.PAWN,
+			expectedMoves: board.MoveMap{
+				board.NORMAL:           {{Rank: 11, File: 10}},
+				board.PAWN_DOUBLE_PUSH: {{Rank: 10, File: 10}},
+			},
+		},
+		{
+			name:              "Green pawn moves west.",
+			position:          board.Position{Rank: 10, File: 12},
+			expectedColor:     board.GREEN,
+			expectedPieceType: board.PAWN,
+			expectedMoves: board.MoveMap{
+				board.NORMAL:           {{Rank: 10, File: 11}},
+				board.PAWN_DOUBLE_PUSH: {{Rank: 10, File: 10}},
+			},
+		},
+		{
+			name:              "Knight can't jump into a corner.",
+			position:          board.Position{Rank: 0, File: 4},
+			expectedColor:     board.RED,
+			expectedPieceType: board.KNIGHT,
+			expectedMoves: board.MoveMap{
+				board.JUMP: {{Rank: 2, File: 5}, {Rank: 2, File: 3}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			piece := fourPlayerBoard.GetState()[tc.position.Rank][tc.position.File]
+			assert.NotNil(t, piece)
+			assert.Equal(t, tc.expectedColor, piece.Color)
+			assert.Equal(t, tc.expectedPieceType, piece.PieceType)
+			for moveType, destinations := range tc.expectedMoves {
+				assert.Equal(t, destinations, piece.AvailableMoves[moveType])
+			}
+		})
+	}
+}
+
+func TestFourPlayerMoves(t *testing.T) {
+	bounds := board.NewCrossBounds(14, 3)
+	testCases := []struct {
+		name                   string
+		state                  board.GameboardState
+		moves                  []board.Move
+		expectErr              bool
+		expectedActive         board.Color
+		expectedEliminated     []board.Color
+		expectedGameEndState   board.GameEndState
+		expectedFullmoveNumber int
+	}{
+		{
+			name: "Checkmated player is eliminated.",
+			state: board.GameboardState{
+				0:  {7: board.NewKing(board.RED)},
+				3:  {1: board.NewRook(board.RED, bounds)},
+				10: {5: board.NewRook(board.RED, bounds)},
+				6:  {0: board.NewKing(board.BLUE), 13: board.NewKing(board.GREEN)},
+				13: {6: board.NewKing(board.YELLOW)},
+			},
+			moves: []board.Move{
+				{
+					Source:      board.Position{Rank: 10, File: 5},
+					Destination: board.Position{Rank: 10, File: 0},
+					MoveType:    board.NORMAL,
+				},
+			},
+			expectedActive:     board.YELLOW,
+			expectedEliminated: []board.Color{board.BLUE},
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+			expectedFullmoveNumber: 1,
+		},
+		{
+			name: "Last player standing wins.",
+			state: board.GameboardState{
+				0:  {7: board.NewKing(board.RED)},
+				3:  {1: board.NewRook(board.RED, bounds)},
+				10: {5: board.NewRook(board.RED, bounds)},
+				6:  {0: board.NewKing(board.BLUE)},
+			},
+			moves: []board.Move{
+				{
+					Source:      board.Position{Rank: 10, File: 5},
+					Destination: board.Position{Rank: 10, File: 0},
+					MoveType:    board.NORMAL,
+				},
+			},
+			expectedActive:     board.RED,
+			expectedEliminated: []board.Color{board.YELLOW, board.GREEN, board.BLUE},
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateLastPlayerStanding,
+				Winner:       board.RED,
+				Loser:        board.BLUE,
+			},
+			expectedFullmoveNumber: 2,
+		},
+		{
+			name: "Blue pawn promotes on the middle file.",
+			state: board.GameboardState{
+				0:  {7: board.NewKing(board.RED)},
+				5:  {6: board.NewPawn(board.BLUE)},
+				7:  {0: board.NewKing(board.BLUE)},
+				13: {6: board.NewKing(board.YELLOW)},
+				6:  {13: board.NewKing(board.GREEN)},
+			},
+			moves: []board.Move{
+				{
+					Source:      board.Position{Rank: 0, File: 7},
+					Destination: board.Position{Rank: 0, File: 6},
+					MoveType:    board.NORMAL,
+				},
+				{
+					Source:             board.Position{Rank: 5, File: 6},
+					Destination:        board.Position{Rank: 5, File: 7},
+					MoveType:           board.PROMOTION,
+					PromotionPieceType: board.QUEEN,
+				},
+			},
+			expectedActive:     board.YELLOW,
+			expectedEliminated: []board.Color{},
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+			expectedFullmoveNumber: 1,
+		},
+		{
+			name: "Full move counted when the turn wraps back to red.",
+			state: board.GameboardState{
+				0:  {7: board.NewKing(board.RED)},
+				7:  {0: board.NewKing(board.BLUE)},
+				13: {6: board.NewKing(board.YELLOW)},
+				6:  {13: board.NewKing(board.GREEN)},
+			},
+			moves: []board.Move{
+				{
+					Source:      board.Position{Rank: 0, File: 7},
+					Destination: board.Position{Rank: 0, File: 6},
+					MoveType:    board.NORMAL,
+				},
+				{
+					Source:      board.Position{Rank: 7, File: 0},
+					Destination: board.Position{Rank: 8, File: 0},
+					MoveType:    board.NORMAL,
+				},
+				{
+					Source:      board.Position{Rank: 13, File: 6},
+					Destination: board.Position{Rank: 13, File: 5},
+					MoveType:    board.NORMAL,
+				},
+				{
+					Source:      board.Position{Rank: 6, File: 13},
+					Destination: board.Position{Rank: 5, File: 13},
+					MoveType:    board.NORMAL,
+				},
+			},
+			expectedActive:     board.RED,
+			expectedEliminated: []board.Color{},
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+			expectedFullmoveNumber: 2,
+		},
+		{
+			name: "Waiting player's piece can't move.",
+			state: board.GameboardState{
+				0:  {7: board.NewKing(board.RED)},
+				7:  {0: board.NewKing(board.BLUE)},
+				13: {6: board.NewKing(board.YELLOW)},
+				6:  {13: board.NewKing(board.GREEN)},
+			},
+			moves: []board.Move{
+				{
+					Source:      board.Position{Rank: 7, File: 0},
+					Destination: board.Position{Rank: 8, File: 0},
+					MoveType:    board.NORMAL,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Piece can't move onto a masked off square.",
+			state: board.GameboardState{
+				0:  {7: board.NewKing(board.RED)},
+				3:  {3: board.NewBishop(board.RED, bounds)},
+				7:  {0: board.NewKing(board.BLUE)},
+				13: {6: board.NewKing(board.YELLOW)},
+				6:  {13: board.NewKing(board.GREEN)},
+			},
+			moves: []board.Move{
+				{
+					Source:      board.Position{Rank: 3, File: 3},
+					Destination: board.Position{Rank: 2, File: 2},
+					MoveType:    board.NORMAL,
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fourPlayerBoard := NewFourPlayerBoard(board.WithGameboardState(tc.state))
+			for _, move := range tc.moves[:len(tc.moves)-1] {
+				assert.Nil(t, fourPlayerBoard.HandleMove(move))
+			}
+
+			err := fourPlayerBoard.HandleMove(tc.moves[len(tc.moves)-1])
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedActive, fourPlayerBoard.GetActivePlayer())
+			assert.Equal(t, tc.expectedEliminated, fourPlayerBoard.GetEliminated())
+			assert.Equal(t, tc.expectedGameEndState, fourPlayerBoard.GetGameEndState())
+			assert.Equal(t, tc.expectedFullmoveNumber, fourPlayerBoard.FullmoveNumber)
+		})
+	}
+}
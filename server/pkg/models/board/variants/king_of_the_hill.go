@@ -0,0 +1,37 @@
+package variants
+
+import (
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func init() {
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeKingOfTheHill,
+		Description: "Standard chess where a player also wins by moving their king to one of the four centre squares.",
+		Bounds:      classicBounds,
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return (&RequestNewKingOfTheHillBoard{FEN: options.FEN}).PerformAction()
+		},
+	})
+}
+
+// NewKingOfTheHillBoard creates a new Board with King of the Hill rules and returns it,
+// the options are applied after the King of the Hill setup.
+// A player wins by checkmate or by moving their king to d4, e4, d5 or e5,
+// a bare king can still reach the hill so there is no insufficient material.
+func NewKingOfTheHillBoard(options ...board.BuilderOption) *board.Board {
+	bounds := classicBounds
+	kingOfTheHillOptions := append(
+		[]board.BuilderOption{
+			board.WithWinCondition(
+				board.NewWinCondition(
+					board.NewKingOfTheHillWinCondition(bounds),
+				),
+			),
+			board.WithInsufficientMaterialRule(nil),
+		},
+		options...,
+	)
+	return NewClassicBoard(kingOfTheHillOptions...)
+}
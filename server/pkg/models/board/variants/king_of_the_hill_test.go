@@ -0,0 +1,63 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func TestNewKingOfTheHillBoard(t *testing.T) {
+	kingOfTheHillBoard := NewKingOfTheHillBoard()
+	assert.Equal(t, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", kingOfTheHillBoard.FEN())
+}
+
+func TestKingOfTheHillMoves(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		fen                  string
+		san                  string
+		expectedGameEndState board.GameEndState
+	}{
+		{
+			name: "King reaches e4.",
+			fen:  "4k3/8/8/8/8/4K3/8/8 w - - 0 1",
+			san:  "Ke4",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateKingOfTheHill,
+				Winner:       board.WHITE,
+				Loser:        board.BLACK,
+			},
+		},
+		{
+			name: "King next to the hill.",
+			fen:  "4k3/8/8/8/8/4K3/8/8 w - - 0 1",
+			san:  "Kf3",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+		},
+		{
+			name: "Checkmate.",
+			fen:  "6k1/5ppp/8/8/8/8/8/R3K3 w - - 0 1",
+			san:  "Ra8",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateCheckmate,
+				Winner:       board.WHITE,
+				Loser:        board.BLACK,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kingOfTheHillBoard, err := (&RequestNewKingOfTheHillBoard{FEN: tc.fen}).PerformAction()
+			assert.Nil(t, err)
+
+			move, err := kingOfTheHillBoard.ParseSAN(tc.san)
+			assert.Nil(t, err)
+			assert.Nil(t, kingOfTheHillBoard.HandleMove(move))
+			assert.Equal(t, tc.expectedGameEndState, kingOfTheHillBoard.GetGameEndState())
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package variants
+
+import (
+	"github.com/variant64/server/pkg/models/board"
+)
+
+var (
+	// gardnerBounds are the Bounds of the 5x5 Gardner board.
+	gardnerBounds = board.Bounds{RankCount: 5, FileCount: 5}
+	// losAlamosBounds are the Bounds of the 6x6 Los Alamos board.
+	losAlamosBounds = board.Bounds{RankCount: 6, FileCount: 6}
+)
+
+func init() {
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeGardner,
+		Description: "Gardner minichess on a 5x5 board without castling or pawn double pushes.",
+		Bounds:      gardnerBounds,
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return (&RequestNewGardnerBoard{FEN: options.FEN}).PerformAction()
+		},
+	})
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeLosAlamos,
+		Description: "Los Alamos chess on a 6x6 board without bishops, castling or pawn double pushes.",
+		Bounds:      losAlamosBounds,
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return (&RequestNewLosAlamosBoard{FEN: options.FEN}).PerformAction()
+		},
+	})
+}
+
+// NewGardnerBoard creates a new Board with Gardner minichess rules on a 5x5 board and returns it,
+// the options are applied after the Gardner setup.
+// Pawns don't double push and kings don't castle.
+func NewGardnerBoard(options ...board.BuilderOption) *board.Board {
+	bounds := gardnerBounds
+
+	gardnerOptions := append(
+		classicRules(
+			bounds,
+			board.NewCastlingState(),
+			board.DoublePushRanks{},
+			board.DefaultPromotionPieceTypes(),
+			nil,
+		),
+		board.WithGameboardState(
+			board.GameboardState{
+				4: gardnerBackRank(board.BLACK, bounds),
+				3: pawnRank(board.BLACK, bounds),
+				1: pawnRank(board.WHITE, bounds),
+				0: gardnerBackRank(board.WHITE, bounds),
+			},
+		),
+	)
+
+	return board.Build(append(gardnerOptions, options...)...)
+}
+
+// gardnerBackRank returns the back rank pieces of the Color, RNBQK from the a-file.
+func gardnerBackRank(color board.Color, bounds board.Bounds) map[int]*board.Piece {
+	return map[int]*board.Piece{
+		0: board.NewRook(color, bounds),
+		1: board.NewKnight(color),
+		2: board.NewBishop(color, bounds),
+		3: board.NewQueen(color, bounds),
+		4: board.NewKing(color),
+	}
+}
+
+// NewLosAlamosBoard creates a new Board with Los Alamos rules on a 6x6 board and returns it,
+// the options are applied after the Los Alamos setup.
+// There are no bishops, pawns don't double push and kings don't castle.
+func NewLosAlamosBoard(options ...board.BuilderOption) *board.Board {
+	bounds := losAlamosBounds
+
+	losAlamosOptions := append(
+		classicRules(
+			bounds,
+			board.NewCastlingState(),
+			board.DoublePushRanks{},
+			losAlamosPromotionPieceTypes(),
+			nil,
+		),
+		board.WithGameboardState(
+			board.GameboardState{
+				5: losAlamosBackRank(board.BLACK, bounds),
+				4: pawnRank(board.BLACK, bounds),
+				1: pawnRank(board.WHITE, bounds),
+				0: losAlamosBackRank(board.WHITE, bounds),
+			},
+		),
+	)
+
+	return board.Build(append(losAlamosOptions, options...)...)
+}
+
+// losAlamosPromotionPieceTypes returns the PieceTypes a pawn can be promoted to in Los Alamos chess.
+func losAlamosPromotionPieceTypes() map[board.PieceType]bool {
+	promotionPieceTypes := board.DefaultPromotionPieceTypes()
+	delete(promotionPieceTypes, board.BISHOP)
+	return promotionPieceTypes
+}
+
+// losAlamosBackRank returns the back rank pieces of the Color, RNQKNR from the a-file.
+func losAlamosBackRank(color board.Color, bounds board.Bounds) map[int]*board.Piece {
+	return map[int]*board.Piece{
+		0: board.NewRook(color, bounds),
+		1: board.NewKnight(color),
+		2: board.NewQueen(color, bounds),
+		3: board.NewKing(color),
+		4: board.NewKnight(color),
+		5: board.NewRook(color, bounds),
+	}
+}
@@ -0,0 +1,114 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func TestNewGardnerBoard(t *testing.T) {
+	gardnerBoard := NewGardnerBoard()
+	assert.Equal(t, "rnbqk/ppppp/5/PPPPP/RNBQK w - - 0 1", gardnerBoard.FEN())
+}
+
+func TestNewLosAlamosBoard(t *testing.T) {
+	losAlamosBoard := NewLosAlamosBoard()
+	assert.Equal(t, "rnqknr/pppppp/6/6/PPPPPP/RNQKNR w - - 0 1", losAlamosBoard.FEN())
+}
+
+func TestMinichessMoves(t *testing.T) {
+	testCases := []struct {
+		name        string
+		request     interface{ PerformAction() (*board.Board, error) }
+		move        board.Move
+		expectErr   bool
+		expectedFEN string
+	}{
+		{
+			name:    "Gardner pawn push.",
+			request: &RequestNewGardnerBoard{},
+			move: board.Move{
+				Source:      board.Position{Rank: 1, File: 0},
+				Destination: board.Position{Rank: 2, File: 0},
+				MoveType:    board.NORMAL,
+			},
+			expectedFEN: "rnbqk/ppppp/P4/1PPPP/RNBQK b - - 0 1",
+		},
+		{
+			name: "Gardner pawn double push not allowed.",
+			request: &RequestNewGardnerBoard{
+				FEN: "rnbqk/5/5/P4/4K w - - 0 1",
+			},
+			move: board.Move{
+				Source:      board.Position{Rank: 1, File: 0},
+				Destination: board.Position{Rank: 3, File: 0},
+				MoveType:    board.PAWN_DOUBLE_PUSH,
+			},
+			expectErr: true,
+		},
+		{
+			name: "Gardner castle not allowed.",
+			request: &RequestNewGardnerBoard{
+				FEN: "4k/5/5/5/R3K w - - 0 1",
+			},
+			move: board.Move{
+				Source:      board.Position{Rank: 0, File: 4},
+				Destination: board.Position{Rank: 0, File: 2},
+				MoveType:    board.QUEENSIDE_CASTLE,
+			},
+			expectErr: true,
+		},
+		{
+			name:    "Los Alamos pawn double push not allowed.",
+			request: &RequestNewLosAlamosBoard{},
+			move: board.Move{
+				Source:      board.Position{Rank: 1, File: 0},
+				Destination: board.Position{Rank: 3, File: 0},
+				MoveType:    board.PAWN_DOUBLE_PUSH,
+			},
+			expectErr: true,
+		},
+		{
+			name: "Los Alamos promotion to knight.",
+			request: &RequestNewLosAlamosBoard{
+				FEN: "3k2/P5/6/6/6/3K2 w - - 0 1",
+			},
+			move: board.Move{
+				Source:             board.Position{Rank: 4, File: 0},
+				Destination:        board.Position{Rank: 5, File: 0},
+				MoveType:           board.PROMOTION,
+				PromotionPieceType: board.KNIGHT,
+			},
+			expectedFEN: "N2k2/6/6/6/6/3K2 b - - 0 1",
+		},
+		{
+			name: "Los Alamos promotion to bishop not allowed.",
+			request: &RequestNewLosAlamosBoard{
+				FEN: "3k2/P5/6/6/6/3K2 w - - 0 1",
+			},
+			move: board.Move{
+				Source:             board.Position{Rank: 4, File: 0},
+				Destination:        board.Position{Rank: 5, File: 0},
+				MoveType:           board.PROMOTION,
+				PromotionPieceType: board.BISHOP,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			minichessBoard, err := tc.request.PerformAction()
+			assert.Nil(t, err)
+
+			err = minichessBoard.HandleMove(tc.move)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedFEN, minichessBoard.FEN())
+		})
+	}
+}
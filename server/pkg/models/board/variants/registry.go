@@ -0,0 +1,77 @@
+package variants
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/variant64/server/pkg/models/board"
+)
+
+// Variant describes a chess variant supported by the server.
+type Variant struct {
+	Name        board.GameboardType `json:"name"`
+	Description string              `json:"description"`
+	Bounds      board.Bounds        `json:"bounds"`
+	PlayerCount int                 `json:"player_count"`
+	// NewBoard creates a Board of the Variant.
+	NewBoard func(options BoardOptions) (*board.Board, error) `json:"-"`
+	// StartingPositionID returns the ID of the starting position of a Board,
+	// it's only set for variants with numbered starting positions.
+	StartingPositionID func(state board.GameboardState) (int, bool) `json:"-"`
+}
+
+// BoardOptions are used to create the Board of a Variant.
+type BoardOptions struct {
+	// FEN is an optional starting position.
+	FEN string
+	// Seed picks the starting position of randomized variants.
+	Seed int64
+}
+
+var variantRegistry = struct {
+	variants map[board.GameboardType]*Variant
+	mux      *sync.RWMutex
+}{
+	variants: map[board.GameboardType]*Variant{},
+	mux:      &sync.RWMutex{},
+}
+
+// RegisterVariant adds the Variant to the registry, replacing any Variant with the same name.
+func RegisterVariant(variant Variant) {
+	variantRegistry.mux.Lock()
+	defer variantRegistry.mux.Unlock()
+
+	variantRegistry.variants[variant.Name] = &variant
+}
+
+// GetVariant returns the registered Variant with the provided name,
+// the default GameboardType is classic chess.
+func GetVariant(name board.GameboardType) (*Variant, error) {
+	if name == board.GameboardTypeDefault {
+		name = board.GameboardTypeClassic
+	}
+
+	variantRegistry.mux.RLock()
+	defer variantRegistry.mux.RUnlock()
+
+	variant, ok := variantRegistry.variants[name]
+	if !ok {
+		return nil, errVariantNotFound(name)
+	}
+	return variant, nil
+}
+
+// GetVariants returns all registered Variants sorted by name.
+func GetVariants() []*Variant {
+	variantRegistry.mux.RLock()
+	defer variantRegistry.mux.RUnlock()
+
+	variants := []*Variant{}
+	for _, variant := range variantRegistry.variants {
+		variants = append(variants, variant)
+	}
+	sort.Slice(variants, func(i, j int) bool {
+		return variants[i].Name < variants[j].Name
+	})
+	return variants
+}
@@ -0,0 +1,91 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func TestGetVariant(t *testing.T) {
+	testCases := []struct {
+		name          string
+		gameboardType board.GameboardType
+		expectedName  board.GameboardType
+		expectedFEN   string
+		expectErr     bool
+	}{
+		{
+			name:          "Default is classic.",
+			gameboardType: board.GameboardTypeDefault,
+			expectedName:  board.GameboardTypeClassic,
+			expectedFEN:   NewClassicBoard().FEN(),
+		},
+		{
+			name:          "Registered variant.",
+			gameboardType: board.GameboardTypeGardner,
+			expectedName:  board.GameboardTypeGardner,
+			expectedFEN:   NewGardnerBoard().FEN(),
+		},
+		{
+			name:          "Unknown variant.",
+			gameboardType: board.GameboardType("unknown"),
+			expectErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			variant, err := GetVariant(tc.gameboardType)
+			if tc.expectErr {
+				assert.Nil(t, variant)
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedName, variant.Name)
+
+			variantBoard, err := variant.NewBoard(BoardOptions{})
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedFEN, variantBoard.FEN())
+		})
+	}
+}
+
+func TestGetVariants(t *testing.T) {
+	variants := GetVariants()
+
+	names := []board.GameboardType{}
+	for _, variant := range variants {
+		names = append(names, variant.Name)
+		assert.NotEmpty(t, variant.Description)
+		assert.GreaterOrEqual(t, variant.PlayerCount, 2)
+		assert.NotNil(t, variant.NewBoard)
+	}
+	assert.IsIncreasing(t, names)
+	assert.Contains(t, names, board.GameboardTypeClassic)
+	assert.Contains(t, names, board.GameboardTypeChess960)
+	assert.Contains(t, names, board.GameboardTypeFourPlayer)
+}
+
+func TestRegisterVariant(t *testing.T) {
+	name := board.GameboardType("test_variant")
+	RegisterVariant(Variant{
+		Name:        name,
+		Description: "Classic chess under another name.",
+		Bounds:      board.Bounds{RankCount: 8, FileCount: 8},
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return NewClassicBoard(), nil
+		},
+	})
+	defer func() {
+		variantRegistry.mux.Lock()
+		defer variantRegistry.mux.Unlock()
+		delete(variantRegistry.variants, name)
+	}()
+
+	variant, err := GetVariant(name)
+	assert.Nil(t, err)
+	assert.Equal(t, name, variant.Name)
+}
@@ -0,0 +1,38 @@
+package variants
+
+import (
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func init() {
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeThreeCheck,
+		Description: "Standard chess where a player also wins by giving check three times.",
+		Bounds:      classicBounds,
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return (&RequestNewThreeCheckBoard{FEN: options.FEN}).PerformAction()
+		},
+	})
+}
+
+// NewThreeCheckBoard creates a new Board with three-check rules and returns it,
+// the options are applied after the three-check setup.
+// A player wins by checkmate or by giving check for the third time,
+// a lone minor piece can still give checks so the classic insufficient material rule doesn't apply.
+func NewThreeCheckBoard(options ...board.BuilderOption) *board.Board {
+	checkCountState := board.NewDefaultCheckCountState()
+	threeCheckOptions := append(
+		[]board.BuilderOption{
+			board.WithCheckCountState(checkCountState),
+			board.WithWinCondition(
+				board.NewWinCondition(
+					board.NewThreeCheckWinCondition(checkCountState),
+				),
+			),
+			board.WithInsufficientMaterialRule(nil),
+		},
+		options...,
+	)
+	return NewClassicBoard(threeCheckOptions...)
+}
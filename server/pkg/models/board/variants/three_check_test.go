@@ -0,0 +1,67 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func TestNewThreeCheckBoard(t *testing.T) {
+	threeCheckBoard := NewThreeCheckBoard()
+	assert.Equal(t, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1 +0+0", threeCheckBoard.FEN())
+	assert.Equal(t, map[board.Color]int{board.WHITE: 0, board.BLACK: 0}, threeCheckBoard.GetCheckCounts())
+}
+
+func TestThreeCheckMoves(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		fen                  string
+		san                  string
+		expectedFEN          string
+		expectedGameEndState board.GameEndState
+	}{
+		{
+			name:        "First check.",
+			fen:         "4k3/8/8/8/8/8/8/R3K3 w - - 0 1",
+			san:         "Ra8+",
+			expectedFEN: "R3k3/8/8/8/8/8/8/4K3 b - - 1 1 +1+0",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+		},
+		{
+			name:        "Third check.",
+			fen:         "4k3/8/8/8/8/8/8/R3K3 w - - 0 1 +2+1",
+			san:         "Ra8+",
+			expectedFEN: "R3k3/8/8/8/8/8/8/4K3 b - - 1 1 +3+1",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateThreeCheck,
+				Winner:       board.WHITE,
+				Loser:        board.BLACK,
+			},
+		},
+		{
+			name:        "Bishop and king keep playing.",
+			fen:         "4k3/8/8/8/8/8/8/2B1K3 w - - 0 1",
+			san:         "Bb2",
+			expectedFEN: "4k3/8/8/8/8/8/1B6/4K3 b - - 1 1 +0+0",
+			expectedGameEndState: board.GameEndState{
+				EndStateType: board.EndStateNone,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			threeCheckBoard, err := (&RequestNewThreeCheckBoard{FEN: tc.fen}).PerformAction()
+			assert.Nil(t, err)
+
+			move, err := threeCheckBoard.ParseSAN(tc.san)
+			assert.Nil(t, err)
+			assert.Nil(t, threeCheckBoard.HandleMove(move))
+			assert.Equal(t, tc.expectedFEN, threeCheckBoard.FEN())
+			assert.Equal(t, tc.expectedGameEndState, threeCheckBoard.GetGameEndState())
+		})
+	}
+}
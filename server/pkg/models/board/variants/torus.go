@@ -0,0 +1,95 @@
+package variants
+
+import (
+	"github.com/variant64/server/pkg/models/board"
+)
+
+// torusBounds are the Bounds of the 8x8 board whose files and ranks both wrap around.
+var torusBounds = board.Bounds{RankCount: 8, FileCount: 8, Topology: board.TopologyTorus}
+
+func init() {
+	RegisterVariant(Variant{
+		Name:        board.GameboardTypeTorus,
+		Description: "Chess on a board whose opposite edges are joined, pieces moving off any side re-enter on the other.",
+		Bounds:      torusBounds,
+		PlayerCount: 2,
+		NewBoard: func(options BoardOptions) (*board.Board, error) {
+			return (&RequestNewTorusBoard{FEN: options.FEN}).PerformAction()
+		},
+	})
+}
+
+// NewTorusBoard creates a new Board with torus chess rules and returns it,
+// the options are applied after the torus chess setup.
+// The armies start a rank in from the edges so the first and last ranks separate them across the wrapped edge,
+// pawns double push from their starting rank and promote on the enemy back rank. Kings don't castle.
+func NewTorusBoard(options ...board.BuilderOption) *board.Board {
+	bounds := torusBounds
+	classicState := classicStartingState(bounds)
+
+	torusOptions := append(
+		torusRules(bounds),
+		board.WithGameboardState(
+			board.GameboardState{
+				6: classicState[7],
+				5: classicState[6],
+				2: classicState[1],
+				1: classicState[0],
+			},
+		),
+	)
+	return board.Build(append(torusOptions, options...)...)
+}
+
+// torusRules returns the BuilderOptions of the torus chess rules on the provided Bounds.
+func torusRules(bounds board.Bounds) []board.BuilderOption {
+	castlingState := board.NewCastlingState()
+	enPassantState := board.NewDefaultEnPassantState()
+	promotionRanks := board.PromotionRanks{
+		board.WHITE: bounds.RankCount - 2,
+		board.BLACK: 1,
+	}
+	doublePushRanks := board.DoublePushRanks{
+		board.WHITE: 2,
+		board.BLACK: bounds.RankCount - 3,
+	}
+
+	return append(
+		classicRules(
+			bounds,
+			castlingState,
+			doublePushRanks,
+			board.DefaultPromotionPieceTypes(),
+			nil,
+		),
+		board.WithEnPassantState(enPassantState),
+		board.WithMoveApplicator(
+			classicMoveApplicator(bounds, castlingState, enPassantState, nil),
+		),
+		board.WithMoveFilter(
+			board.NewMoveFilter(
+				&board.FilterOutOfBounds{Bounds: bounds},
+				&board.FilterPieceCollision{Bounds: bounds},
+				&board.FilterFriendlyCapture{},
+				&board.FilterInvalidPawnDoublePush{
+					DoublePushRanks: doublePushRanks,
+				},
+				&board.FilterIllegalEnPassant{
+					EnPassantState: enPassantState,
+				},
+				&board.FilterIllegalPromotion{
+					PromotionRanks: promotionRanks,
+				},
+				&board.FilterIllegalPromotionCapture{
+					PromotionRanks: promotionRanks,
+				},
+				&board.FilterMissingPromotion{
+					PromotionRanks: promotionRanks,
+				},
+				&board.FilterIllegalPromotionPieceType{
+					PieceTypes: board.DefaultPromotionPieceTypes(),
+				},
+			),
+		),
+	)
+}
@@ -0,0 +1,67 @@
+package variants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
+)
+
+func TestNewTorusBoard(t *testing.T) {
+	torusBoard := NewTorusBoard()
+	assert.Equal(t, "8/rnbqkbnr/pppppppp/8/8/PPPPPPPP/RNBQKBNR/8 w - - 0 1", torusBoard.FEN())
+	assert.Equal(t, board.Topology(board.TopologyTorus), torusBoard.GetBounds().Topology)
+	assert.Equal(t, board.EndStateNone, torusBoard.GetGameEndState().EndStateType)
+	assert.Equal(t, board.CheckStateNone, torusBoard.GetCheckState())
+}
+
+func TestTorusMoves(t *testing.T) {
+	testCases := []struct {
+		name        string
+		fen         string
+		san         string
+		expectErr   bool
+		expectedFEN string
+	}{
+		{
+			name:        "Knight leaps from the second rank onto the eighth.",
+			fen:         "8/rnbqkbnr/pppppppp/8/8/PPPPPPPP/RNBQKBNR/8 w - - 0 1",
+			san:         "Nc8",
+			expectedFEN: "2N5/rnbqkbnr/pppppppp/8/8/PPPPPPPP/R1BQKBNR/8 b - - 1 1",
+		},
+		{
+			name:        "Rook moves from the first rank onto the eighth.",
+			fen:         "8/8/8/7k/8/8/P7/R3K3 w - - 0 1",
+			san:         "Ra8",
+			expectedFEN: "R7/8/8/7k/8/8/P7/4K3 b - - 1 1",
+		},
+		{
+			name:        "Pawn promotes on the enemy back rank.",
+			fen:         "8/8/P7/8/2k5/8/8/4K3 w - - 0 1",
+			san:         "a7=Q",
+			expectedFEN: "8/Q7/8/8/2k5/8/8/4K3 b - - 0 1",
+		},
+		{
+			name:      "Pawn can't double push from outside its starting rank.",
+			fen:       "4k3/8/8/8/8/8/P7/4K3 w - - 0 1",
+			san:       "a4",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			torusBoard, err := (&RequestNewTorusBoard{FEN: tc.fen}).PerformAction()
+			assert.Nil(t, err)
+
+			move, err := torusBoard.ParseSAN(tc.san)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Nil(t, torusBoard.HandleMove(move))
+			assert.Equal(t, tc.expectedFEN, torusBoard.FEN())
+		})
+	}
+}
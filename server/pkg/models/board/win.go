@@ -0,0 +1,149 @@
+package board
+
+type winCondition interface {
+	// GetWinner returns the Color that has won on the provided state, false if none has.
+	GetWinner(state GameboardState) (Color, bool)
+	// GetEndStateType returns the EndStateType of a game won by the condition.
+	GetEndStateType() EndStateType
+}
+
+// WinCondition bundles multiple winConditions into a single struct.
+type WinCondition struct {
+	winConditions []winCondition
+}
+
+// CheckWin returns the EndStateType and winning Color of the first winCondition a player has won by.
+func (w *WinCondition) CheckWin(state GameboardState) (EndStateType, Color, bool) {
+	for _, condition := range w.winConditions {
+		if winner, ok := condition.GetWinner(state); ok {
+			return condition.GetEndStateType(), winner, true
+		}
+	}
+	return EndStateNone, NO_COLOR, false
+}
+
+func NewWinCondition(winConditions ...winCondition) *WinCondition {
+	return &WinCondition{
+		winConditions: winConditions,
+	}
+}
+
+// AddWinCondition adds a winCondition checked after the existing ones.
+func (w *WinCondition) AddWinCondition(condition winCondition) {
+	w.winConditions = append(w.winConditions, condition)
+}
+
+// KingOfTheHillWinCondition wins the game for the player whose king reaches one of the hill squares.
+type KingOfTheHillWinCondition struct {
+	Squares []Position
+}
+
+// NewKingOfTheHillWinCondition returns a KingOfTheHillWinCondition on the centre squares of the Bounds,
+// e.g. d4, e4, d5 and e5 on an 8x8 board.
+func NewKingOfTheHillWinCondition(bounds Bounds) *KingOfTheHillWinCondition {
+	squares := []Position{}
+	for _, rank := range centreLines(bounds.RankCount) {
+		for _, file := range centreLines(bounds.FileCount) {
+			squares = append(squares, Position{Rank: rank, File: file})
+		}
+	}
+	return &KingOfTheHillWinCondition{Squares: squares}
+}
+
+// centreLines returns the middle line of an odd count of lines or the two middle lines of an even count.
+func centreLines(count int) []int {
+	if count%2 == 1 {
+		return []int{count / 2}
+	}
+	return []int{count/2 - 1, count / 2}
+}
+
+func (k *KingOfTheHillWinCondition) GetWinner(state GameboardState) (Color, bool) {
+	for _, square := range k.Squares {
+		piece := state[square.Rank][square.File]
+		if piece != nil && piece.PieceType == KING {
+			return piece.Color, true
+		}
+	}
+	return NO_COLOR, false
+}
+
+func (k *KingOfTheHillWinCondition) GetEndStateType() EndStateType {
+	return EndStateKingOfTheHill
+}
+
+// threeCheckChecks is the number of checks that wins a game of three-check.
+const threeCheckChecks = 3
+
+// CheckCountWinCondition wins the game for the player who has given a number of checks.
+type CheckCountWinCondition struct {
+	*CheckCountState
+	// ChecksToWin is the number of checks that wins the game.
+	ChecksToWin int
+}
+
+// NewThreeCheckWinCondition returns a CheckCountWinCondition won by giving three checks counted by the CheckCountState.
+func NewThreeCheckWinCondition(checkCountState *CheckCountState) *CheckCountWinCondition {
+	return &CheckCountWinCondition{
+		CheckCountState: checkCountState,
+		ChecksToWin:     threeCheckChecks,
+	}
+}
+
+func (c *CheckCountWinCondition) GetWinner(state GameboardState) (Color, bool) {
+	for color, checks := range c.Checks {
+		if checks >= c.ChecksToWin {
+			return color, true
+		}
+	}
+	return NO_COLOR, false
+}
+
+func (c *CheckCountWinCondition) GetEndStateType() EndStateType {
+	return EndStateThreeCheck
+}
+
+// KingExplosionWinCondition wins the game for the player whose king is the last one on the board.
+type KingExplosionWinCondition struct{}
+
+func (k *KingExplosionWinCondition) GetWinner(state GameboardState) (Color, bool) {
+	kings := findKings(state)
+	if len(kings) != 1 {
+		return NO_COLOR, false
+	}
+	for color := range kings {
+		return color, true
+	}
+	return NO_COLOR, false
+}
+
+func (k *KingExplosionWinCondition) GetEndStateType() EndStateType {
+	return EndStateKingExploded
+}
+
+// NoMovesWinCondition wins the game for the active player once they have no moves left,
+// either because all their pieces were captured or because they are stalemated.
+type NoMovesWinCondition struct {
+	*TurnState
+}
+
+func (n *NoMovesWinCondition) GetWinner(state GameboardState) (Color, bool) {
+	active := n.GetActivePlayer()
+	for _, files := range state {
+		for _, piece := range files {
+			if piece == nil || piece.Color != active {
+				continue
+			}
+			for _, destinations := range piece.AvailableMoves {
+				if len(destinations) > 0 {
+					return NO_COLOR, false
+				}
+			}
+		}
+	}
+	return active, true
+}
+
+func (n *NoMovesWinCondition) GetEndStateType() EndStateType {
+	return EndStateNoMovesLeft
+}
@@ -0,0 +1,309 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewKingOfTheHillWinCondition(t *testing.T) {
+	testCases := []struct {
+		name            string
+		bounds          Bounds
+		expectedSquares []Position
+	}{
+		{
+			name:   "Classic board.",
+			bounds: Bounds{RankCount: 8, FileCount: 8},
+			expectedSquares: []Position{
+				{Rank: 3, File: 3},
+				{Rank: 3, File: 4},
+				{Rank: 4, File: 3},
+				{Rank: 4, File: 4},
+			},
+		},
+		{
+			name:   "Odd board.",
+			bounds: Bounds{RankCount: 5, FileCount: 5},
+			expectedSquares: []Position{
+				{Rank: 2, File: 2},
+			},
+		},
+		{
+			name:   "Wide board.",
+			bounds: Bounds{RankCount: 8, FileCount: 10},
+			expectedSquares: []Position{
+				{Rank: 3, File: 4},
+				{Rank: 3, File: 5},
+				{Rank: 4, File: 4},
+				{Rank: 4, File: 5},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			winCondition := NewKingOfTheHillWinCondition(tc.bounds)
+			assert.Equal(t, tc.expectedSquares, winCondition.Squares)
+		})
+	}
+}
+
+func TestKingOfTheHill(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		fen                  string
+		move                 Move
+		expectedGameEndState GameEndState
+	}{
+		{
+			name: "King reaches the hill.",
+			fen:  "4k3/8/8/8/8/4K3/8/8 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 2, File: 4},
+				Destination: Position{Rank: 3, File: 4},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateKingOfTheHill,
+				Winner:       WHITE,
+				Loser:        BLACK,
+			},
+		},
+		{
+			name: "Black king reaches the hill.",
+			fen:  "8/8/3k4/8/8/8/8/4K3 b - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 5, File: 3},
+				Destination: Position{Rank: 4, File: 3},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateKingOfTheHill,
+				Winner:       BLACK,
+				Loser:        WHITE,
+			},
+		},
+		{
+			name: "Other pieces on the hill don't win.",
+			fen:  "4k3/8/8/8/8/8/8/3QK3 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 0, File: 3},
+				Destination: Position{Rank: 3, File: 3},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateNone,
+			},
+		},
+		{
+			name: "Checkmate still wins.",
+			fen:  "6k1/5ppp/8/8/8/8/8/R3K3 w - - 0 1",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateCheckmate,
+				Winner:       WHITE,
+				Loser:        BLACK,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bounds := Bounds{RankCount: 8, FileCount: 8}
+			position, err := ParseFEN(tc.fen, bounds)
+			assert.Nil(t, err)
+			board := Build(
+				WithFEN(position),
+				WithWinCondition(NewWinCondition(NewKingOfTheHillWinCondition(bounds))),
+			)
+
+			assert.Nil(t, board.HandleMove(tc.move))
+			assert.Equal(t, tc.expectedGameEndState, board.GetGameEndState())
+		})
+	}
+}
+
+func TestThreeCheck(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		fen                  string
+		move                 Move
+		expectedGameEndState GameEndState
+	}{
+		{
+			name: "Third check wins.",
+			fen:  "4k3/8/8/8/8/8/8/R3K3 w - - 0 1 +2+0",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateThreeCheck,
+				Winner:       WHITE,
+				Loser:        BLACK,
+			},
+		},
+		{
+			name: "Third check by black wins.",
+			fen:  "r3k3/8/8/8/8/8/8/4K3 b - - 0 1 +0+2",
+			move: Move{
+				Source:      Position{Rank: 7, File: 0},
+				Destination: Position{Rank: 0, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateThreeCheck,
+				Winner:       BLACK,
+				Loser:        WHITE,
+			},
+		},
+		{
+			name: "Second check doesn't win.",
+			fen:  "4k3/8/8/8/8/8/8/R3K3 w - - 0 1 +1+0",
+			move: Move{
+				Source:      Position{Rank: 0, File: 0},
+				Destination: Position{Rank: 7, File: 0},
+				MoveType:    NORMAL,
+			},
+			expectedGameEndState: GameEndState{
+				EndStateType: EndStateNone,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bounds := Bounds{RankCount: 8, FileCount: 8}
+			position, err := ParseFEN(tc.fen, bounds)
+			assert.Nil(t, err)
+			checkCountState := NewDefaultCheckCountState()
+			board := Build(
+				WithCheckCountState(checkCountState),
+				WithWinCondition(NewWinCondition(NewThreeCheckWinCondition(checkCountState))),
+				WithFEN(position),
+				WithInsufficientMaterialRule(nil),
+			)
+
+			assert.Nil(t, board.HandleMove(tc.move))
+			assert.Equal(t, tc.expectedGameEndState, board.GetGameEndState())
+		})
+	}
+}
+
+func TestKingExplosionWinCondition(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	testCases := []struct {
+		name           string
+		state          GameboardState
+		expectedWinner Color
+		expectedWon    bool
+	}{
+		{
+			name: "Both kings on the board.",
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {4: NewKing(WHITE)},
+					7: {4: NewKing(BLACK)},
+				},
+			),
+			expectedWinner: NO_COLOR,
+		},
+		{
+			name: "Black king exploded.",
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {4: NewKing(WHITE)},
+				},
+			),
+			expectedWinner: WHITE,
+			expectedWon:    true,
+		},
+		{
+			name: "White king exploded.",
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					7: {4: NewKing(BLACK)},
+				},
+			),
+			expectedWinner: BLACK,
+			expectedWon:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			winner, won := (&KingExplosionWinCondition{}).GetWinner(tc.state)
+			assert.Equal(t, tc.expectedWinner, winner)
+			assert.Equal(t, tc.expectedWon, won)
+		})
+	}
+}
+
+func TestNoMovesWinCondition(t *testing.T) {
+	bounds := Bounds{RankCount: 8, FileCount: 8}
+	movingRook := NewRook(WHITE, bounds)
+	movingRook.AvailableMoves = MoveMap{NORMAL: []Position{{Rank: 1, File: 0}}}
+	testCases := []struct {
+		name           string
+		state          GameboardState
+		expectedWinner Color
+		expectedWon    bool
+	}{
+		{
+			name: "Active player has a move.",
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {0: movingRook},
+					7: {0: NewRook(BLACK, bounds)},
+				},
+			),
+			expectedWinner: NO_COLOR,
+		},
+		{
+			name: "Active player has no pieces.",
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					7: {0: NewRook(BLACK, bounds)},
+				},
+			),
+			expectedWinner: WHITE,
+			expectedWon:    true,
+		},
+		{
+			name: "Active player is stalemated.",
+			state: NewGameboardState(
+				bounds,
+				GameboardState{
+					0: {0: NewRook(WHITE, bounds)},
+				},
+			),
+			expectedWinner: WHITE,
+			expectedWon:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			winCondition := &NoMovesWinCondition{
+				TurnState: &TurnState{
+					Active:    WHITE,
+					TurnOrder: []Color{BLACK, WHITE},
+				},
+			}
+			winner, won := winCondition.GetWinner(tc.state)
+			assert.Equal(t, tc.expectedWinner, winner)
+			assert.Equal(t, tc.expectedWon, won)
+		})
+	}
+}
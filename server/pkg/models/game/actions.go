@@ -2,6 +2,7 @@ package game
 
 import (
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/variant64/server/pkg/models"
@@ -19,6 +20,11 @@ type RequestNewGame struct {
 	PlayerOrder     []uuid.UUID         `json:"player_order" swaggerignore:"true"`
 	PlayerTimeMilis int64               `json:"player_time_ms"`
 	GameboardType   board.GameboardType `json:"gameboard_type"`
+	FEN             string              `json:"fen"`
+	// PGN is an optional game whose moves are replayed on the new Game.
+	PGN string `json:"pgn"`
+	// Seed picks the starting position of randomized variants, a random one is used if zero.
+	Seed int64 `json:"seed"`
 }
 
 // PerformAction creates a new Game.
@@ -27,18 +33,46 @@ func (r *RequestNewGame) PerformAction() (*Game, error) {
 		return nil, errInvalidPlayersNumber(len(r.PlayerOrder))
 	}
 
+	// An imported PGN provides the starting position and variant.
+	gameboardType, fen := r.GameboardType, r.FEN
+	var pgn *board.PGN
+	if r.PGN != "" {
+		parsed, err := board.ParsePGN(r.PGN)
+		if err != nil {
+			return nil, err
+		}
+		pgn = parsed
+		if pgnFEN, ok := pgn.GetTag(pgnTagFEN); ok {
+			fen = pgnFEN
+		}
+		if variant, ok := pgn.GetTag(pgnTagVariant); ok && gameboardType == board.GameboardTypeDefault {
+			gameboardType = pgnGameboardType(variant)
+		}
+	}
+
+	variant, err := variants.GetVariant(gameboardType)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.PlayerOrder) != variant.PlayerCount {
+		return nil, errInvalidPlayersNumber(len(r.PlayerOrder))
+	}
+
 	game := &Game{
-		ID:           uuid.New(),
-		ActivePlayer: r.PlayerOrder[0],
-		Clocks:       map[uuid.UUID]int64{},
-		playerOrder:  append(r.PlayerOrder[1:], r.PlayerOrder[0]),
-		playerTimers: make(map[uuid.UUID]*timer.Timer),
-		Winners:      []uuid.UUID{},
-		Losers:       []uuid.UUID{},
-		Drawn:        []uuid.UUID{},
-		ApprovedDraw: map[uuid.UUID]bool{},
-		State:        StateNotStarted,
-		mux:          &sync.RWMutex{},
+		ID:            uuid.New(),
+		ActivePlayer:  r.PlayerOrder[0],
+		Clocks:        map[uuid.UUID]int64{},
+		playerOrder:   append(r.PlayerOrder[1:], r.PlayerOrder[0]),
+		playerTimers:  make(map[uuid.UUID]*timer.Timer),
+		Winners:       []uuid.UUID{},
+		Losers:        []uuid.UUID{},
+		Drawn:         []uuid.UUID{},
+		ApprovedDraw:  map[uuid.UUID]bool{},
+		State:         StateNotStarted,
+		Moves:         []MoveRecord{},
+		GameboardType: gameboardType,
+		players:       append([]uuid.UUID{}, r.PlayerOrder...),
+		mux:           &sync.RWMutex{},
 	}
 
 	handler, err := models.NewUpdatePub(game.ID, gameUpdateBus)
@@ -47,11 +81,29 @@ func (r *RequestNewGame) PerformAction() (*Game, error) {
 	}
 	game.updateHandler = handler
 
-	gameboard, err := newGameboard(r.GameboardType)
+	seed := r.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	gameboard, err := variant.NewBoard(variants.BoardOptions{FEN: fen, Seed: seed})
 	if err != nil {
 		return nil, err
 	}
 	game.board = gameboard
+	game.colors = gameboard.GetColors()
+	if pgn != nil && !gameboard.IsFENSupported() {
+		return nil, errFENNotSupported(gameboardType)
+	}
+	// Games that don't start from the classic position record it for their PGN.
+	if (fen != "" || variant.Name != board.GameboardTypeClassic) && gameboard.IsFENSupported() {
+		game.startFEN = gameboard.FEN()
+	}
+	if variant.StartingPositionID != nil {
+		if id, ok := variant.StartingPositionID(gameboard.GetState()); ok {
+			game.StartingPositionID = &id
+		}
+	}
 
 	for _, player := range r.PlayerOrder {
 		game.ApprovedDraw[player] = false
@@ -65,6 +117,13 @@ func (r *RequestNewGame) PerformAction() (*Game, error) {
 		game.Clocks[player] = r.PlayerTimeMilis
 	}
 
+	if pgn != nil {
+		err = game.replayPGN(pgn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	gameStore := getGameStore()
 	gameStore.Lock()
 	defer gameStore.Unlock()
@@ -74,16 +133,6 @@ func (r *RequestNewGame) PerformAction() (*Game, error) {
 	return game, nil
 }
 
-// newGameboard returns a gameboard based on the request type.
-func newGameboard(gameboardType board.GameboardType) (gameboard, error) {
-	switch gameboardType {
-	case board.GameboardTypeDefault, board.GameboardTypeClassic:
-		return (&variants.RequestNewClassicBoard{}).PerformAction()
-	default:
-		return nil, errUnableToCreateBoard
-	}
-}
-
 // RequestGetGame is used to get a Game by its ID.
 type RequestGetGame struct {
 	GameID uuid.UUID `json:"game_id"`
@@ -103,6 +152,62 @@ func (r *RequestGetGame) PerformAction() (*Game, error) {
 	return game, nil
 }
 
+// RequestGetGameFEN is used to get the FEN of a Game's board.
+type RequestGetGameFEN struct {
+	GameID uuid.UUID `json:"game_id" mapstructure:"game_id"`
+}
+
+// PerformAction loads a Game's FEN.
+func (r *RequestGetGameFEN) PerformAction() (*GameFEN, error) {
+	game, err := (&RequestGetGame{GameID: r.GameID}).PerformAction()
+	if err != nil {
+		return nil, err
+	}
+
+	if !game.isFENSupported() {
+		return nil, errFENNotSupported(game.GameboardType)
+	}
+
+	fen := game.getFEN()
+	return &fen, nil
+}
+
+// RequestGetGamePGN is used to export a Game as PGN.
+type RequestGetGamePGN struct {
+	GameID uuid.UUID `json:"game_id" mapstructure:"game_id"`
+}
+
+// PerformAction exports a Game as PGN.
+func (r *RequestGetGamePGN) PerformAction() (*GamePGN, error) {
+	game, err := (&RequestGetGame{GameID: r.GameID}).PerformAction()
+	if err != nil {
+		return nil, err
+	}
+
+	if !game.isFENSupported() {
+		return nil, errFENNotSupported(game.GameboardType)
+	}
+
+	pgn := game.getPGN()
+	return &pgn, nil
+}
+
+// RequestGetGameMoves is used to get the moves played in a Game.
+type RequestGetGameMoves struct {
+	GameID uuid.UUID `json:"game_id" mapstructure:"game_id"`
+}
+
+// PerformAction loads a Game's moves.
+func (r *RequestGetGameMoves) PerformAction() (*GameMoves, error) {
+	game, err := (&RequestGetGame{GameID: r.GameID}).PerformAction()
+	if err != nil {
+		return nil, err
+	}
+
+	moves := game.getMoves()
+	return &moves, nil
+}
+
 // RequestStartGame is used to start a Game.
 type RequestStartGame struct {
 	GameID uuid.UUID `json:"game_id"`
@@ -164,6 +269,28 @@ func (r *RequestApproveDraw) PerformAction() (*Game, error) {
 	return game, nil
 }
 
+// RequestClaimDraw is used to claim a draw in a Game,
+// e.g. by the fifty-move rule or threefold repetition.
+type RequestClaimDraw struct {
+	GameID   uuid.UUID `json:"game_id" mapstructure:"game_id" swaggerignore:"true"`
+	PlayerID uuid.UUID `json:"player_id"`
+}
+
+// PerformAction claims a draw for one player in a Game.
+func (r *RequestClaimDraw) PerformAction() (*Game, error) {
+	game, err := (&RequestGetGame{GameID: r.GameID}).PerformAction()
+	if err != nil {
+		return nil, err
+	}
+
+	err = game.claimDraw(r.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return game, nil
+}
+
 // RequestRejectDraw is used to reject a draw for a Game.
 type RequestRejectDraw struct {
 	GameID uuid.UUID `json:"game_id" mapstructure:"game_id" swaggerignore:"true"`
@@ -186,9 +313,12 @@ func (r *RequestRejectDraw) PerformAction() (*Game, error) {
 
 // RequestMakeMove is used to make a move in a Game.
 type RequestMakeMove struct {
-	GameID   uuid.UUID  `json:"game_id" mapstructure:"game_id" swaggerignore:"true"`
-	PlayerID uuid.UUID  `json:"player_id"`
-	Move     board.Move `json:"move"`
+	GameID   uuid.UUID `json:"game_id" mapstructure:"game_id" swaggerignore:"true"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// Move is the move to make, a drop from the reserve has the drop move type and a drop piece type.
+	Move board.Move `json:"move"`
+	// SAN is an alternative to Move in Standard or Long Algebraic Notation, e.g. "Nf3" or "N@f3" for a drop.
+	SAN string `json:"san"`
 }
 
 // PerformAction makes a move on the board if it's valid.
@@ -198,7 +328,7 @@ func (r *RequestMakeMove) PerformAction() (*Game, error) {
 		return nil, err
 	}
 
-	err = game.makeMove(r.PlayerID, r.Move)
+	err = game.makeMove(r.PlayerID, r.Move, r.SAN)
 	if err != nil {
 		return nil, err
 	}
@@ -211,6 +341,7 @@ const (
 
 	GameSubscribe   string = "subscribe"
 	GameUnsubscribe string = "unsubscribe"
+	GameMakeMove    string = "move"
 )
 
 // CommandGameSubscribe represents a game subscribe command.
@@ -239,11 +370,23 @@ func (c *CommandGameUnsubscribe) PerformAction(conn *websocket.Conn) error {
 	return nil
 }
 
+// CommandGameMakeMove represents a game make move command.
+type CommandGameMakeMove struct {
+	RequestMakeMove
+}
+
+func (c *CommandGameMakeMove) PerformAction() error {
+	_, err := c.RequestMakeMove.PerformAction()
+	return err
+}
+
 // HandleCommand handles all incoming game writer messages.
 func HandleCommand(writer models.EventWriter, command, body string) error {
 	switch {
 	case command == GameSubscribe:
 		return models.HandleCommand(models.MarshallCommand(body, &CommandGameSubscribe{EventWriter: writer}))
+	case command == GameMakeMove:
+		return models.HandleCommand(models.MarshallCommand(body, &CommandGameMakeMove{}))
 	default:
 		return models.ErrInvalidCommand
 	}
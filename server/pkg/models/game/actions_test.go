@@ -1,16 +1,22 @@
 package game
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models"
+	"github.com/variant64/server/pkg/models/board"
+	"github.com/variant64/server/pkg/models/board/variants"
+	"github.com/variant64/server/pkg/models/player"
 )
 
 func TestRequestNewGameValid(t *testing.T) {
 	playerID1 := uuid.New()
 	playerID2 := uuid.New()
 	playerID3 := uuid.New()
+	playerID4 := uuid.New()
 	testcases := []struct {
 		name                 string
 		request              RequestNewGame
@@ -27,13 +33,14 @@ func TestRequestNewGameValid(t *testing.T) {
 			expectedPlayerOrder:  []uuid.UUID{playerID2, playerID1},
 		},
 		{
-			name: "New game, three players",
+			name: "New game, four players",
 			request: RequestNewGame{
-				PlayerOrder:     []uuid.UUID{playerID1, playerID2, playerID3},
+				PlayerOrder:     []uuid.UUID{playerID1, playerID2, playerID3, playerID4},
 				PlayerTimeMilis: 1_000,
+				GameboardType:   board.GameboardTypeFourPlayer,
 			},
 			expectedActivePlayer: playerID1,
-			expectedPlayerOrder:  []uuid.UUID{playerID2, playerID3, playerID1},
+			expectedPlayerOrder:  []uuid.UUID{playerID2, playerID3, playerID4, playerID1},
 		},
 	}
 
@@ -53,8 +60,9 @@ func TestRequestNewGameValid(t *testing.T) {
 func TestRequestNewGameInvalid(t *testing.T) {
 	playerID1 := uuid.New()
 	testcases := []struct {
-		name    string
-		request RequestNewGame
+		name          string
+		request       RequestNewGame
+		expectedError string
 	}{
 		{
 			name: "New game without enough players.",
@@ -63,14 +71,41 @@ func TestRequestNewGameInvalid(t *testing.T) {
 				PlayerTimeMilis: 1_000,
 			},
 		},
+		{
+			name: "New game with too many players.",
+			request: RequestNewGame{
+				PlayerOrder:     []uuid.UUID{playerID1, uuid.New(), uuid.New()},
+				PlayerTimeMilis: 1_000,
+			},
+		},
+		{
+			name: "New game with an invalid FEN.",
+			request: RequestNewGame{
+				PlayerOrder:     []uuid.UUID{playerID1, uuid.New()},
+				PlayerTimeMilis: 1_000,
+				FEN:             "not a fen",
+			},
+		},
+		{
+			name: "New game with an unknown variant.",
+			request: RequestNewGame{
+				PlayerOrder:     []uuid.UUID{playerID1, uuid.New()},
+				PlayerTimeMilis: 1_000,
+				GameboardType:   "unknown",
+			},
+			expectedError: `Variant error: variant "unknown" not found`,
+		},
 	}
 
 	for _, tc := range testcases {
-		t.Run(t.Name(), func(t *testing.T) {
+		t.Run(tc.name, func(t *testing.T) {
 			game, err := tc.request.PerformAction()
 
 			assert.Nil(t, game)
 			assert.NotNil(t, err)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			}
 		})
 	}
 }
@@ -130,6 +165,721 @@ func TestGetGameInvalid(t *testing.T) {
 	}
 }
 
+func TestGetGameFEN(t *testing.T) {
+	playerID1 := uuid.New()
+	playerID2 := uuid.New()
+	fen := "4k3/8/8/8/4P3/8/8/4K3 b - e3 0 1"
+	defaultGame, _ := (&RequestNewGame{
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
+		PlayerTimeMilis: 1_000,
+	}).PerformAction()
+	fenGame, _ := (&RequestNewGame{
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
+		PlayerTimeMilis: 1_000,
+		FEN:             fen,
+	}).PerformAction()
+
+	testcases := []struct {
+		name        string
+		request     RequestGetGameFEN
+		expectedFEN string
+	}{
+		{
+			name: "Game from the starting position.",
+			request: RequestGetGameFEN{
+				GameID: defaultGame.GetID(),
+			},
+			expectedFEN: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+		},
+		{
+			name: "Game from a FEN.",
+			request: RequestGetGameFEN{
+				GameID: fenGame.GetID(),
+			},
+			expectedFEN: fen,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			gameFEN, err := tc.request.PerformAction()
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.request.GameID, gameFEN.ID)
+			assert.Equal(t, tc.expectedFEN, gameFEN.FEN)
+		})
+	}
+}
+
+func TestGetGameMoves(t *testing.T) {
+	playerID1 := uuid.New()
+	playerID2 := uuid.New()
+	game, _ := (&RequestNewGame{
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
+		PlayerTimeMilis: 60_000,
+	}).PerformAction()
+	game.start()
+
+	moves := []RequestMakeMove{
+		{GameID: game.GetID(), PlayerID: playerID1, SAN: "e4"},
+		{GameID: game.GetID(), PlayerID: playerID2, SAN: "e5"},
+		{GameID: game.GetID(), PlayerID: playerID1, SAN: "Qh5"},
+		{GameID: game.GetID(), PlayerID: playerID2, SAN: "Nc6"},
+		{GameID: game.GetID(), PlayerID: playerID1, SAN: "Bc4"},
+		{GameID: game.GetID(), PlayerID: playerID2, SAN: "Nf6"},
+		{GameID: game.GetID(), PlayerID: playerID1, SAN: "Qxf7"},
+	}
+	for _, move := range moves {
+		_, err := move.PerformAction()
+		assert.Nil(t, err)
+	}
+
+	gameMoves, err := (&RequestGetGameMoves{GameID: game.GetID()}).PerformAction()
+	assert.Nil(t, err)
+	assert.Equal(t, game.GetID(), gameMoves.ID)
+	assert.Len(t, gameMoves.Moves, len(moves))
+
+	expectedSANs := []string{"e4", "e5", "Qh5", "Nc6", "Bc4", "Nf6", "Qxf7#"}
+	for i, record := range gameMoves.Moves {
+		assert.Equal(t, i+1, record.Ply)
+		assert.Equal(t, moves[i].PlayerID, record.PlayerID)
+		assert.Equal(t, expectedSANs[i], record.SAN)
+		assert.Equal(t, int64(60_000), record.ClockMilis)
+	}
+
+	lastMove := gameMoves.Moves[len(gameMoves.Moves)-1]
+	assert.Equal(t, board.Position{Rank: 6, File: 5}, lastMove.Move.Destination)
+	assert.Equal(t, board.CheckStateCheckmate, lastMove.CheckState)
+	assert.Equal(t, &board.Piece{Color: board.BLACK, PieceType: board.PAWN}, lastMove.CapturedPiece)
+	assert.Nil(t, gameMoves.Moves[0].CapturedPiece)
+	assert.Equal(t, board.CheckStateNone, gameMoves.Moves[0].CheckState)
+}
+
+func TestGetGameMovesInvalid(t *testing.T) {
+	gameMoves, err := (&RequestGetGameMoves{GameID: uuid.New()}).PerformAction()
+	assert.Nil(t, gameMoves)
+	assert.NotNil(t, err)
+}
+
+func TestGetGamePGN(t *testing.T) {
+	white, _ := (&player.RequestNewPlayer{DisplayName: "alice"}).PerformAction()
+	black, _ := (&player.RequestNewPlayer{DisplayName: "bob"}).PerformAction()
+	game, _ := (&RequestNewGame{
+		PlayerOrder:     []uuid.UUID{white.GetID(), black.GetID()},
+		PlayerTimeMilis: 60_000,
+	}).PerformAction()
+	game.start()
+
+	for _, move := range []RequestMakeMove{
+		{GameID: game.GetID(), PlayerID: white.GetID(), SAN: "e4"},
+		{GameID: game.GetID(), PlayerID: black.GetID(), SAN: "e5"},
+	} {
+		_, err := move.PerformAction()
+		assert.Nil(t, err)
+	}
+	_, err := (&RequestConcede{GameID: game.GetID(), PlayerID: black.GetID()}).PerformAction()
+	assert.Nil(t, err)
+
+	gamePGN, err := (&RequestGetGamePGN{GameID: game.GetID()}).PerformAction()
+	assert.Nil(t, err)
+	assert.Equal(t, game.GetID(), gamePGN.ID)
+	assert.Equal(
+		t,
+		fmt.Sprintf(`[Event "?"]
+[Site "?"]
+[Date "%s"]
+[Round "?"]
+[White "alice"]
+[Black "bob"]
+[Result "1-0"]
+[Variant "classic"]
+
+1. e4 {[%%clk 0:01:00]} 1... e5 {[%%clk 0:01:00]} 1-0
+`, game.startedAt.Format("2006.01.02")),
+		gamePGN.PGN,
+	)
+}
+
+func TestRequestNewGamePGN(t *testing.T) {
+	playerID1 := uuid.New()
+	playerID2 := uuid.New()
+	testcases := []struct {
+		name                 string
+		pgn                  string
+		expectedFEN          string
+		expectedSANs         []string
+		expectedClocks       []int64
+		expectedActivePlayer uuid.UUID
+	}{
+		{
+			name:                 "Moves with clocks.",
+			pgn:                  "1. e4 {[%clk 0:04:59]} 1... e5 {[%clk 0:04:58]} 2. Nf3 *",
+			expectedFEN:          "rnbqkbnr/pppp1ppp/8/4p3/4P3/5N2/PPPP1PPP/RNBQKB1R b KQkq - 1 2",
+			expectedSANs:         []string{"e4", "e5", "Nf3"},
+			expectedClocks:       []int64{299_000, 298_000, 60_000},
+			expectedActivePlayer: playerID2,
+		},
+		{
+			name: "Moves from a FEN.",
+			pgn: `[SetUp "1"]
+[FEN "4k3/8/8/8/4P3/8/8/4K3 b - e3 0 12"]
+
+12... Kd7 13. e5 *`,
+			expectedFEN:          "8/3k4/8/4P3/8/8/8/4K3 b - - 0 13",
+			expectedSANs:         []string{"Kd7", "e5"},
+			expectedClocks:       []int64{60_000, 60_000},
+			expectedActivePlayer: playerID1,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			game, err := (&RequestNewGame{
+				PlayerOrder:     []uuid.UUID{playerID1, playerID2},
+				PlayerTimeMilis: 60_000,
+				PGN:             tc.pgn,
+			}).PerformAction()
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedFEN, game.getFEN().FEN)
+			assert.Equal(t, tc.expectedActivePlayer, game.ActivePlayer)
+			assert.Len(t, game.Moves, len(tc.expectedSANs))
+			for i, record := range game.Moves {
+				assert.Equal(t, tc.expectedSANs[i], record.SAN)
+				assert.Equal(t, tc.expectedClocks[i], record.ClockMilis)
+			}
+
+			// The imported moves are exported unchanged.
+			pgn, err := board.ParsePGN(game.getPGN().PGN)
+			assert.Nil(t, err)
+			for i, pgnMove := range pgn.Moves {
+				assert.Equal(t, tc.expectedSANs[i], pgnMove.SAN)
+			}
+		})
+	}
+}
+
+func TestRequestNewGamePGNResult(t *testing.T) {
+	playerID1 := uuid.New()
+	playerID2 := uuid.New()
+	testcases := []struct {
+		name              string
+		pgn               string
+		expectedWinners   []uuid.UUID
+		expectedLosers    []uuid.UUID
+		expectedEndReason board.EndStateType
+	}{
+		{
+			name:              "Mated game.",
+			pgn:               "1. f3 e5 2. g4 Qh4# 0-1",
+			expectedWinners:   []uuid.UUID{playerID2},
+			expectedLosers:    []uuid.UUID{playerID1},
+			expectedEndReason: board.EndStateCheckmate,
+		},
+		{
+			name:              "Mated game without a result.",
+			pgn:               "1. f3 e5 2. g4 Qh4# *",
+			expectedWinners:   []uuid.UUID{playerID2},
+			expectedLosers:    []uuid.UUID{playerID1},
+			expectedEndReason: board.EndStateCheckmate,
+		},
+		{
+			name:            "Resigned game.",
+			pgn:             "1. e4 e5 2. Qh5 Nc6 1-0",
+			expectedWinners: []uuid.UUID{playerID1},
+			expectedLosers:  []uuid.UUID{playerID2},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			game, err := (&RequestNewGame{
+				PlayerOrder:     []uuid.UUID{playerID1, playerID2},
+				PlayerTimeMilis: 60_000,
+				PGN:             tc.pgn,
+			}).PerformAction()
+			assert.Nil(t, err)
+
+			assert.Equal(t, StateFinished, game.State)
+			assert.Equal(t, tc.expectedWinners, game.Winners)
+			assert.Equal(t, tc.expectedLosers, game.Losers)
+			assert.Equal(t, tc.expectedEndReason, game.EndReason)
+			assert.NotNil(t, game.start())
+		})
+	}
+}
+
+func TestRequestNewGameThreeCheck(t *testing.T) {
+	playerID1 := uuid.New()
+	playerID2 := uuid.New()
+	game, err := (&RequestNewGame{
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
+		PlayerTimeMilis: 60_000,
+		GameboardType:   board.GameboardTypeThreeCheck,
+		FEN:             "4k3/8/8/8/8/8/8/R3K3 w - - 0 1 +0+1",
+	}).PerformAction()
+	assert.Nil(t, err)
+	assert.Equal(t, &map[uuid.UUID]int{playerID1: 0, playerID2: 1}, game.getSnapshot().Checks)
+
+	game.start()
+	_, err = (&RequestMakeMove{GameID: game.GetID(), PlayerID: playerID1, SAN: "Ra8+"}).PerformAction()
+	assert.Nil(t, err)
+	assert.Equal(t, &map[uuid.UUID]int{playerID1: 1, playerID2: 1}, game.getSnapshot().Checks)
+	assert.Equal(t, "R3k3/8/8/8/8/8/8/4K3 b - - 1 1 +1+1", game.getFEN().FEN)
+}
+
+func TestRequestNewGameCrazyhouse(t *testing.T) {
+	playerID1 := uuid.New()
+	playerID2 := uuid.New()
+	game, err := (&RequestNewGame{
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
+		PlayerTimeMilis: 60_000,
+		GameboardType:   board.GameboardTypeCrazyhouse,
+		FEN:             "4k3/8/8/3p4/4P3/8/8/4K3[] w - - 0 1",
+	}).PerformAction()
+	assert.Nil(t, err)
+	assert.Equal(
+		t,
+		&map[uuid.UUID]map[board.PieceType]int{playerID1: {}, playerID2: {}},
+		game.getSnapshot().Reserves,
+	)
+
+	game.start()
+	_, err = (&RequestMakeMove{GameID: game.GetID(), PlayerID: playerID1, SAN: "exd5"}).PerformAction()
+	assert.Nil(t, err)
+	_, err = (&RequestMakeMove{GameID: game.GetID(), PlayerID: playerID2, SAN: "Ke7"}).PerformAction()
+	assert.Nil(t, err)
+	assert.Equal(
+		t,
+		&map[uuid.UUID]map[board.PieceType]int{playerID1: {board.PAWN: 1}, playerID2: {}},
+		game.getSnapshot().Reserves,
+	)
+
+	_, err = (&RequestMakeMove{
+		GameID:   game.GetID(),
+		PlayerID: playerID1,
+		Move: board.Move{
+			Destination:   board.Position{Rank: 3, File: 4},
+			MoveType:      board.DROP,
+			DropPieceType: board.PAWN,
+		},
+	}).PerformAction()
+	assert.Nil(t, err)
+	assert.Equal(t, "P@e4", game.Moves[2].SAN)
+	assert.Equal(
+		t,
+		&map[uuid.UUID]map[board.PieceType]int{playerID1: {}, playerID2: {}},
+		game.getSnapshot().Reserves,
+	)
+	assert.Equal(t, "8/4k3/8/3P4/4P3/8/8/4K3[] b - - 0 2", game.getFEN().FEN)
+}
+
+func TestRequestNewGameFourPlayer(t *testing.T) {
+	_, err := (&RequestNewGame{
+		PlayerOrder:     []uuid.UUID{uuid.New(), uuid.New()},
+		PlayerTimeMilis: 60_000,
+		GameboardType:   board.GameboardTypeFourPlayer,
+	}).PerformAction()
+	assert.Equal(t, errInvalidPlayersNumber(2), err)
+
+	players := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+	game, err := (&RequestNewGame{
+		PlayerOrder:     players,
+		PlayerTimeMilis: 60_000,
+		GameboardType:   board.GameboardTypeFourPlayer,
+	}).PerformAction()
+	assert.Nil(t, err)
+
+	// FEN and PGN only describe white and black.
+	assert.Empty(t, game.startFEN)
+	_, err = (&RequestGetGameFEN{GameID: game.GetID()}).PerformAction()
+	assert.Equal(t, errFENNotSupported(board.GameboardTypeFourPlayer), err)
+	_, err = (&RequestGetGamePGN{GameID: game.GetID()}).PerformAction()
+	assert.Equal(t, errFENNotSupported(board.GameboardTypeFourPlayer), err)
+	_, err = (&RequestNewGame{
+		PlayerOrder:     players,
+		PlayerTimeMilis: 60_000,
+		PGN:             "[Variant \"four_player\"]\n\n*",
+	}).PerformAction()
+	assert.Equal(t, errFENNotSupported(board.GameboardTypeFourPlayer), err)
+
+	// Red checkmates blue who is eliminated, yellow moves next.
+	bounds := board.NewCrossBounds(14, 3)
+	assert.Equal(t, &bounds, game.getSnapshot().Bounds)
+	game.board = variants.NewFourPlayerBoard(
+		board.WithGameboardState(
+			board.GameboardState{
+				0:  {7: board.NewKing(board.RED)},
+				3:  {1: board.NewRook(board.RED, bounds)},
+				10: {5: board.NewRook(board.RED, bounds)},
+				6:  {0: board.NewKing(board.BLUE), 13: board.NewKing(board.GREEN)},
+				13: {6: board.NewKing(board.YELLOW)},
+			},
+		),
+	)
+	game.start()
+	_, err = (&RequestMakeMove{
+		GameID:   game.GetID(),
+		PlayerID: players[0],
+		Move: board.Move{
+			Source:      board.Position{Rank: 10, File: 5},
+			Destination: board.Position{Rank: 10, File: 0},
+			MoveType:    board.NORMAL,
+		},
+	}).PerformAction()
+	assert.Nil(t, err)
+	assert.Equal(t, StateStarted, game.State)
+	assert.Equal(t, players[2], game.ActivePlayer)
+	assert.Equal(t, []uuid.UUID{players[1]}, game.Losers)
+	assert.Equal(t, []uuid.UUID{players[3], players[0], players[2]}, game.playerOrder)
+
+	_, err = (&RequestMakeMove{
+		GameID:   game.GetID(),
+		PlayerID: players[1],
+		SAN:      "Kg13",
+	}).PerformAction()
+	assert.NotNil(t, err)
+
+	// Red is the last player standing once the kingless players and checkmated blue are eliminated.
+	game, err = (&RequestNewGame{
+		PlayerOrder:     players,
+		PlayerTimeMilis: 60_000,
+		GameboardType:   board.GameboardTypeFourPlayer,
+	}).PerformAction()
+	assert.Nil(t, err)
+	game.board = variants.NewFourPlayerBoard(
+		board.WithGameboardState(
+			board.GameboardState{
+				0:  {7: board.NewKing(board.RED)},
+				3:  {1: board.NewRook(board.RED, bounds)},
+				10: {5: board.NewRook(board.RED, bounds)},
+				6:  {0: board.NewKing(board.BLUE)},
+			},
+		),
+	)
+	game.start()
+	_, err = (&RequestMakeMove{
+		GameID:   game.GetID(),
+		PlayerID: players[0],
+		Move: board.Move{
+			Source:      board.Position{Rank: 10, File: 5},
+			Destination: board.Position{Rank: 10, File: 0},
+			MoveType:    board.NORMAL,
+		},
+	}).PerformAction()
+	assert.Nil(t, err)
+	assert.Equal(t, StateFinished, game.State)
+	assert.Equal(t, []uuid.UUID{players[0]}, game.Winners)
+	assert.Equal(t, []uuid.UUID{players[2], players[3], players[1]}, game.Losers)
+	assert.Equal(t, board.EndStateLastPlayerStanding, game.EndReason)
+}
+
+func TestRequestNewGameChess960(t *testing.T) {
+	playerID1 := uuid.New()
+	playerID2 := uuid.New()
+	game, err := (&RequestNewGame{
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
+		PlayerTimeMilis: 60_000,
+		GameboardType:   board.GameboardTypeChess960,
+		Seed:            960,
+	}).PerformAction()
+	assert.Nil(t, err)
+
+	expectedID := variants.Chess960PositionIDFromSeed(960)
+	assert.Equal(t, &expectedID, game.StartingPositionID)
+	assert.Equal(t, &expectedID, game.getSnapshot().StartingPositionID)
+	assert.Equal(t, variants.NewChess960Board(960).FEN(), game.getFEN().FEN)
+
+	// The exported PGN replays from the same starting position.
+	game.start()
+	_, err = (&RequestMakeMove{GameID: game.GetID(), PlayerID: playerID1, SAN: "a4"}).PerformAction()
+	assert.Nil(t, err)
+
+	pgn := game.getPGN().PGN
+	assert.Contains(t, pgn, fmt.Sprintf("[Variant \"%s\"]", board.GameboardTypeChess960))
+	assert.Contains(t, pgn, fmt.Sprintf("[FEN \"%s\"]", variants.NewChess960Board(960).FEN()))
+
+	imported, err := (&RequestNewGame{
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
+		PlayerTimeMilis: 60_000,
+		PGN:             pgn,
+	}).PerformAction()
+	assert.Nil(t, err)
+	assert.Equal(t, board.GameboardTypeChess960, imported.GameboardType)
+	assert.Equal(t, &expectedID, imported.StartingPositionID)
+	assert.Equal(t, game.getFEN().FEN, imported.getFEN().FEN)
+}
+
+func TestRequestNewGameCapablanca(t *testing.T) {
+	game, err := (&RequestNewGame{
+		PlayerOrder:     []uuid.UUID{uuid.New(), uuid.New()},
+		PlayerTimeMilis: 60_000,
+		GameboardType:   board.GameboardTypeCapablanca,
+	}).PerformAction()
+	assert.Nil(t, err)
+
+	assert.Equal(t, board.GameboardTypeCapablanca, game.GameboardType)
+	assert.Equal(t, variants.NewCapablancaBoard().FEN(), game.getFEN().FEN)
+	assert.Equal(t, &board.Bounds{RankCount: 8, FileCount: 10}, game.getSnapshot().Bounds)
+	assert.Contains(t, game.getPGN().PGN, fmt.Sprintf("[Variant \"%s\"]", board.GameboardTypeCapablanca))
+}
+
+func TestRequestNewGameMinichess(t *testing.T) {
+	testcases := []struct {
+		name          string
+		gameboardType board.GameboardType
+		expectedFEN   string
+	}{
+		{
+			name:          "Gardner.",
+			gameboardType: board.GameboardTypeGardner,
+			expectedFEN:   variants.NewGardnerBoard().FEN(),
+		},
+		{
+			name:          "Los Alamos.",
+			gameboardType: board.GameboardTypeLosAlamos,
+			expectedFEN:   variants.NewLosAlamosBoard().FEN(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			game, err := (&RequestNewGame{
+				PlayerOrder:     []uuid.UUID{uuid.New(), uuid.New()},
+				PlayerTimeMilis: 60_000,
+				GameboardType:   tc.gameboardType,
+			}).PerformAction()
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.gameboardType, game.GameboardType)
+			assert.Equal(t, tc.expectedFEN, game.getFEN().FEN)
+			assert.Contains(t, game.getPGN().PGN, fmt.Sprintf("[Variant \"%s\"]", tc.gameboardType))
+		})
+	}
+}
+
+func TestRequestNewGamePGNInvalid(t *testing.T) {
+	testcases := []struct {
+		name string
+		pgn  string
+	}{
+		{
+			name: "Unparseable PGN.",
+			pgn:  "1. e4 {unterminated",
+		},
+		{
+			name: "Illegal move.",
+			pgn:  "1. e4 e5 2. Ke3 *",
+		},
+		{
+			name: "Result doesn't match the board.",
+			pgn:  "1. f3 e5 2. g4 Qh4# 1-0",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			game, err := (&RequestNewGame{
+				PlayerOrder:     []uuid.UUID{uuid.New(), uuid.New()},
+				PlayerTimeMilis: 60_000,
+				PGN:             tc.pgn,
+			}).PerformAction()
+			assert.Nil(t, game)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestClaimDraw(t *testing.T) {
+	playerID1 := uuid.New()
+	playerID2 := uuid.New()
+	testcases := []struct {
+		name          string
+		fen           string
+		request       func(gameID uuid.UUID) RequestClaimDraw
+		expectErr     bool
+		expectedState gameState
+		expectedDrawn []uuid.UUID
+	}{
+		{
+			name: "Claim by the fifty-move rule.",
+			fen:  "4k3/8/8/8/8/8/8/R3K3 w - - 100 60",
+			request: func(gameID uuid.UUID) RequestClaimDraw {
+				return RequestClaimDraw{GameID: gameID, PlayerID: playerID2}
+			},
+			expectedState: StateFinished,
+			expectedDrawn: []uuid.UUID{playerID2, playerID1},
+		},
+		{
+			name: "Claim too early.",
+			fen:  "4k3/8/8/8/8/8/8/R3K3 w - - 20 60",
+			request: func(gameID uuid.UUID) RequestClaimDraw {
+				return RequestClaimDraw{GameID: gameID, PlayerID: playerID1}
+			},
+			expectErr:     true,
+			expectedState: StateStarted,
+			expectedDrawn: []uuid.UUID{},
+		},
+		{
+			name: "Claim by a player not in the game.",
+			fen:  "4k3/8/8/8/8/8/8/R3K3 w - - 100 60",
+			request: func(gameID uuid.UUID) RequestClaimDraw {
+				return RequestClaimDraw{GameID: gameID, PlayerID: uuid.New()}
+			},
+			expectErr:     true,
+			expectedState: StateStarted,
+			expectedDrawn: []uuid.UUID{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			game, _ := (&RequestNewGame{
+				PlayerOrder:     []uuid.UUID{playerID1, playerID2},
+				PlayerTimeMilis: 1_000,
+				FEN:             tc.fen,
+			}).PerformAction()
+			game.start()
+
+			request := tc.request(game.GetID())
+			_, err := request.PerformAction()
+			if tc.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tc.expectedState, game.State)
+			assert.ElementsMatch(t, tc.expectedDrawn, game.Drawn)
+		})
+	}
+}
+
+func TestMakeMoveGameEnd(t *testing.T) {
+	playerID1 := uuid.New()
+	playerID2 := uuid.New()
+	testcases := []struct {
+		name              string
+		gameboardType     board.GameboardType
+		fen               string
+		san               string
+		expectedWinners   []uuid.UUID
+		expectedLosers    []uuid.UUID
+		expectedDrawn     []uuid.UUID
+		expectedEndReason board.EndStateType
+	}{
+		{
+			name:              "Checkmate.",
+			fen:               "6k1/5ppp/8/8/8/8/8/R3K3 w - - 0 1",
+			san:               "Ra8#",
+			expectedWinners:   []uuid.UUID{playerID1},
+			expectedLosers:    []uuid.UUID{playerID2},
+			expectedDrawn:     []uuid.UUID{},
+			expectedEndReason: board.EndStateCheckmate,
+		},
+		{
+			name:              "Checkmate by black.",
+			fen:               "r3k3/8/8/8/8/8/5PPP/6K1 b - - 0 1",
+			san:               "Ra1#",
+			expectedWinners:   []uuid.UUID{playerID1},
+			expectedLosers:    []uuid.UUID{playerID2},
+			expectedDrawn:     []uuid.UUID{},
+			expectedEndReason: board.EndStateCheckmate,
+		},
+		{
+			name:              "Seventy-five-move rule.",
+			fen:               "4k3/8/8/8/8/8/8/R3K3 w - - 149 90",
+			san:               "Rb1",
+			expectedWinners:   []uuid.UUID{},
+			expectedLosers:    []uuid.UUID{},
+			expectedDrawn:     []uuid.UUID{playerID2, playerID1},
+			expectedEndReason: board.EndStateSeventyFiveMoveRule,
+		},
+		{
+			name:              "Insufficient material.",
+			fen:               "4k3/8/8/8/8/8/3r4/2B1K3 w - - 0 1",
+			san:               "Bxd2",
+			expectedWinners:   []uuid.UUID{},
+			expectedLosers:    []uuid.UUID{},
+			expectedDrawn:     []uuid.UUID{playerID2, playerID1},
+			expectedEndReason: board.EndStateInsufficientMaterial,
+		},
+		{
+			name:              "King of the hill.",
+			gameboardType:     board.GameboardTypeKingOfTheHill,
+			fen:               "4k3/8/8/8/8/4K3/8/8 w - - 0 1",
+			san:               "Ke4",
+			expectedWinners:   []uuid.UUID{playerID1},
+			expectedLosers:    []uuid.UUID{playerID2},
+			expectedDrawn:     []uuid.UUID{},
+			expectedEndReason: board.EndStateKingOfTheHill,
+		},
+		{
+			name:              "Three-check.",
+			gameboardType:     board.GameboardTypeThreeCheck,
+			fen:               "4k3/8/8/8/8/8/8/R3K3 w - - 0 1 +2+0",
+			san:               "Ra8+",
+			expectedWinners:   []uuid.UUID{playerID1},
+			expectedLosers:    []uuid.UUID{playerID2},
+			expectedDrawn:     []uuid.UUID{},
+			expectedEndReason: board.EndStateThreeCheck,
+		},
+		{
+			name:              "Atomic king explosion.",
+			gameboardType:     board.GameboardTypeAtomic,
+			fen:               "4k3/4q3/8/8/8/8/8/4R1K1 w - - 0 1",
+			san:               "Rxe7",
+			expectedWinners:   []uuid.UUID{playerID1},
+			expectedLosers:    []uuid.UUID{playerID2},
+			expectedDrawn:     []uuid.UUID{},
+			expectedEndReason: board.EndStateKingExploded,
+		},
+		{
+			name:              "Crazyhouse checkmate by a drop.",
+			gameboardType:     board.GameboardTypeCrazyhouse,
+			fen:               "6k1/5ppp/8/8/8/8/8/4K3[R] w - - 0 1",
+			san:               "R@a8#",
+			expectedWinners:   []uuid.UUID{playerID1},
+			expectedLosers:    []uuid.UUID{playerID2},
+			expectedDrawn:     []uuid.UUID{},
+			expectedEndReason: board.EndStateCheckmate,
+		},
+		{
+			name:              "Antichess losing all pieces.",
+			gameboardType:     board.GameboardTypeAntichess,
+			fen:               "8/8/8/8/8/8/3k4/4K3 w - - 0 1",
+			san:               "Kxd2",
+			expectedWinners:   []uuid.UUID{playerID2},
+			expectedLosers:    []uuid.UUID{playerID1},
+			expectedDrawn:     []uuid.UUID{},
+			expectedEndReason: board.EndStateNoMovesLeft,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			game, _ := (&RequestNewGame{
+				PlayerOrder:     []uuid.UUID{playerID1, playerID2},
+				PlayerTimeMilis: 1_000,
+				GameboardType:   tc.gameboardType,
+				FEN:             tc.fen,
+			}).PerformAction()
+			game.start()
+
+			_, err := (&RequestMakeMove{
+				GameID:   game.GetID(),
+				PlayerID: playerID1,
+				SAN:      tc.san,
+			}).PerformAction()
+			assert.Nil(t, err)
+			assert.Equal(t, StateFinished, game.State)
+			assert.Equal(t, tc.expectedWinners, game.Winners)
+			assert.Equal(t, tc.expectedLosers, game.Losers)
+			assert.ElementsMatch(t, tc.expectedDrawn, game.Drawn)
+			assert.Equal(t, tc.expectedEndReason, game.EndReason)
+		})
+	}
+}
+
 func TestStartGame(t *testing.T) {
 	playerID1 := uuid.New()
 	playerID2 := uuid.New()
@@ -196,9 +946,8 @@ func TestStartGameInvalid(t *testing.T) {
 func TestConcedeGame(t *testing.T) {
 	playerID1 := uuid.New()
 	playerID2 := uuid.New()
-	playerID3 := uuid.New()
 	game, _ := (&RequestNewGame{
-		PlayerOrder:     []uuid.UUID{playerID1, playerID2, playerID3},
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
 		PlayerTimeMilis: 1_000,
 	}).PerformAction()
 	game.start()
@@ -215,7 +964,7 @@ func TestConcedeGame(t *testing.T) {
 				GameID:   game.GetID(),
 				PlayerID: playerID1,
 			},
-			expectedWinners: []uuid.UUID{playerID2, playerID3},
+			expectedWinners: []uuid.UUID{playerID2},
 			expectedLosers:  []uuid.UUID{playerID1},
 		},
 	}
@@ -235,13 +984,12 @@ func TestConcedeGame(t *testing.T) {
 func TestConcedeGameInvalid(t *testing.T) {
 	playerID1 := uuid.New()
 	playerID2 := uuid.New()
-	playerID3 := uuid.New()
 	game1, _ := (&RequestNewGame{
-		PlayerOrder:     []uuid.UUID{playerID1, playerID2, playerID3},
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
 		PlayerTimeMilis: 1_000,
 	}).PerformAction()
 	game2, _ := (&RequestNewGame{
-		PlayerOrder:     []uuid.UUID{playerID1, playerID2, playerID3},
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
 		PlayerTimeMilis: 1_000,
 	}).PerformAction()
 	game2.State = StateFinished
@@ -279,9 +1027,8 @@ func TestConcedeGameInvalid(t *testing.T) {
 func TestDrawProcessValid(t *testing.T) {
 	playerID1 := uuid.New()
 	playerID2 := uuid.New()
-	playerID3 := uuid.New()
 	game, _ := (&RequestNewGame{
-		PlayerOrder:     []uuid.UUID{playerID1, playerID2, playerID3},
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
 		PlayerTimeMilis: 1_000,
 	}).PerformAction()
 	game.start()
@@ -296,19 +1043,18 @@ func TestDrawProcessValid(t *testing.T) {
 	}{
 		{
 			"All accept draw.",
-			[]uuid.UUID{playerID1, playerID2, playerID3},
+			[]uuid.UUID{playerID1, playerID2},
 			[]RequestApproveDraw{
 				{PlayerID: playerID1},
 				{PlayerID: playerID2},
-				{PlayerID: playerID3},
 			},
 			[]RequestRejectDraw{},
-			[]uuid.UUID{playerID2, playerID3, playerID1},
+			[]uuid.UUID{playerID2, playerID1},
 			StateFinished,
 		},
 		{
 			"Duplicate accept draw.",
-			[]uuid.UUID{playerID1, playerID2, playerID3},
+			[]uuid.UUID{playerID1, playerID2},
 			[]RequestApproveDraw{
 				{PlayerID: playerID1},
 				{PlayerID: playerID1},
@@ -320,10 +1066,9 @@ func TestDrawProcessValid(t *testing.T) {
 		},
 		{
 			"One rejects draw.",
-			[]uuid.UUID{playerID1, playerID2, playerID3},
+			[]uuid.UUID{playerID1, playerID2},
 			[]RequestApproveDraw{
 				{PlayerID: playerID1},
-				{PlayerID: playerID2},
 			},
 			[]RequestRejectDraw{
 				{},
@@ -354,6 +1099,10 @@ func TestDrawProcessValid(t *testing.T) {
 
 			assert.Equal(t, tc.expectedDrawnPlayers, game.Drawn)
 			assert.Equal(t, tc.expectedState, game.State)
+
+			// The result doesn't follow later changes to the player order.
+			game.playerOrder[0] = uuid.New()
+			assert.Equal(t, tc.expectedDrawnPlayers, game.Drawn)
 		})
 	}
 }
@@ -361,9 +1110,8 @@ func TestDrawProcessValid(t *testing.T) {
 func TestAcceptDrawInvalid(t *testing.T) {
 	playerID1 := uuid.New()
 	playerID2 := uuid.New()
-	playerID3 := uuid.New()
 	game, _ := (&RequestNewGame{
-		PlayerOrder:     []uuid.UUID{playerID1, playerID2, playerID3},
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
 		PlayerTimeMilis: 1_000,
 	}).PerformAction()
 	game.start()
@@ -376,7 +1124,7 @@ func TestAcceptDrawInvalid(t *testing.T) {
 	}{
 		{
 			"Player not in game.",
-			[]uuid.UUID{playerID1, playerID2, playerID3},
+			[]uuid.UUID{playerID1, playerID2},
 			[]RequestApproveDraw{
 				{PlayerID: uuid.New()},
 			},
@@ -406,9 +1154,8 @@ func TestAcceptDrawInvalid(t *testing.T) {
 func TestRejectDrawInvalid(t *testing.T) {
 	playerID1 := uuid.New()
 	playerID2 := uuid.New()
-	playerID3 := uuid.New()
 	game, _ := (&RequestNewGame{
-		PlayerOrder:     []uuid.UUID{playerID1, playerID2, playerID3},
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
 		PlayerTimeMilis: 1_000,
 	}).PerformAction()
 	game.start()
@@ -422,11 +1169,10 @@ func TestRejectDrawInvalid(t *testing.T) {
 	}{
 		{
 			"Game already drawn.",
-			[]uuid.UUID{playerID1, playerID2, playerID3},
+			[]uuid.UUID{playerID1, playerID2},
 			[]RequestApproveDraw{
 				{PlayerID: playerID1},
 				{PlayerID: playerID2},
-				{PlayerID: playerID3},
 			},
 			[]RequestRejectDraw{
 				{},
@@ -459,3 +1205,78 @@ func TestRejectDrawInvalid(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleCommandMakeMove(t *testing.T) {
+	playerID1 := uuid.New()
+	playerID2 := uuid.New()
+	game, _ := (&RequestNewGame{
+		PlayerOrder:     []uuid.UUID{playerID1, playerID2},
+		PlayerTimeMilis: 1_000,
+	}).PerformAction()
+	game.start()
+
+	testcases := []struct {
+		name                 string
+		body                 string
+		expectErr            bool
+		expectedActivePlayer uuid.UUID
+	}{
+		{
+			name: "Move by the wrong player.",
+			body: fmt.Sprintf(
+				`{"game_id":"%s","player_id":"%s","move":{"source":{"rank":6,"file":4},"destination":{"rank":4,"file":4},"move_type":"pawn_double_push"}}`,
+				game.GetID(), playerID2,
+			),
+			expectErr:            true,
+			expectedActivePlayer: playerID1,
+		},
+		{
+			name: "Move not allowed.",
+			body: fmt.Sprintf(
+				`{"game_id":"%s","player_id":"%s","move":{"source":{"rank":1,"file":4},"destination":{"rank":4,"file":4},"move_type":"normal"}}`,
+				game.GetID(), playerID1,
+			),
+			expectErr:            true,
+			expectedActivePlayer: playerID1,
+		},
+		{
+			name: "Valid move.",
+			body: fmt.Sprintf(
+				`{"game_id":"%s","player_id":"%s","move":{"source":{"rank":1,"file":4},"destination":{"rank":3,"file":4},"move_type":"pawn_double_push"}}`,
+				game.GetID(), playerID1,
+			),
+			expectErr:            false,
+			expectedActivePlayer: playerID2,
+		},
+		{
+			name: "Invalid SAN move.",
+			body: fmt.Sprintf(
+				`{"game_id":"%s","player_id":"%s","san":"e4"}`,
+				game.GetID(), playerID2,
+			),
+			expectErr:            true,
+			expectedActivePlayer: playerID2,
+		},
+		{
+			name: "Valid SAN move.",
+			body: fmt.Sprintf(
+				`{"game_id":"%s","player_id":"%s","san":"Nc6"}`,
+				game.GetID(), playerID2,
+			),
+			expectErr:            false,
+			expectedActivePlayer: playerID1,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := HandleCommand(models.NewMockEventWriter(), GameMakeMove, tc.body)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tc.expectedActivePlayer, game.ActivePlayer)
+		})
+	}
+}
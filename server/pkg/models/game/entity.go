@@ -2,6 +2,7 @@ package game
 
 import (
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/variant64/server/pkg/models"
@@ -19,7 +20,32 @@ const (
 
 type gameboard interface {
 	GetState() board.GameboardState
+	GetBounds() board.Bounds
 	HandleMove(move board.Move) error
+	ParseSAN(san string) (board.Move, error)
+	MoveToSAN(move board.Move) (string, error)
+	GetCapturedPiece(move board.Move) *board.Piece
+	GetCheckState() board.CheckState
+	GetActivePlayer() board.Color
+	GetGameEndState() board.GameEndState
+	GetCheckCounts() map[board.Color]int
+	GetReserves() map[board.Color]map[board.PieceType]int
+	GetColors() []board.Color
+	GetEliminated() []board.Color
+	ClaimDraw() error
+	FEN() string
+	IsFENSupported() bool
+}
+
+// MoveRecord represents a move played in a Game.
+type MoveRecord struct {
+	Ply           int              `json:"ply"`
+	PlayerID      uuid.UUID        `json:"player_id"`
+	Move          board.Move       `json:"move"`
+	SAN           string           `json:"san"`
+	CapturedPiece *board.Piece     `json:"captured_piece,omitempty"`
+	CheckState    board.CheckState `json:"check_state"`
+	ClockMilis    int64            `json:"clock_ms"`
 }
 
 // Game represents an on-going game between a list of players.
@@ -35,10 +61,21 @@ type Game struct {
 	Losers       []uuid.UUID        `json:"losing_players"`
 	Drawn        []uuid.UUID        `json:"drawn_players"`
 	ApprovedDraw map[uuid.UUID]bool `json:"approved_draw_players"`
+	// EndReason is how the board ended the game, e.g. checkmate.
+	EndReason board.EndStateType `json:"end_reason,omitempty"`
 
 	State gameState `json:"state"`
 
-	board gameboard
+	Moves []MoveRecord `json:"moves"`
+
+	GameboardType board.GameboardType `json:"gameboard_type"`
+	// StartingPositionID identifies the starting position of variants with several, e.g. Chess960.
+	StartingPositionID *int `json:"starting_position_id,omitempty"`
+	board              gameboard
+	startFEN           string
+	colors             []board.Color
+	players            []uuid.UUID
+	startedAt          time.Time
 
 	updateHandler *models.UpdatePublisher[GameUpdate]
 
@@ -58,9 +95,23 @@ type GameUpdate struct {
 
 	ApprovedDraw *map[uuid.UUID]bool `json:"approved_draw_players,omitempty"`
 
+	EndReason *board.EndStateType `json:"end_reason,omitempty"`
+
+	// Checks maps the players to the number of checks they have given in variants that count them.
+	Checks *map[uuid.UUID]int `json:"checks,omitempty"`
+
+	// Reserves maps the players to the pieces they can drop in variants with reserves.
+	Reserves *map[uuid.UUID]map[board.PieceType]int `json:"reserves,omitempty"`
+
 	State *gameState `json:"state,omitempty"`
 
+	Moves *[]MoveRecord `json:"moves,omitempty"`
+
+	StartingPositionID *int `json:"starting_position_id,omitempty"`
+
 	BoardState board.GameboardState `json:"gameboard_state,omitempty"`
+	// Bounds is the size of the board and the squares masked off it, e.g. the corners in four-player chess.
+	Bounds *board.Bounds `json:"bounds,omitempty"`
 }
 
 // Build returns a GameUpdate.
@@ -89,6 +140,7 @@ func (g *Game) start() error {
 	}
 	g.playerTimers[g.ActivePlayer].Unpause()
 	g.State = StateStarted
+	g.startedAt = time.Now()
 
 	g.updateHandler.Publish(
 		models.UpdateMessage[GameUpdate]{
@@ -107,11 +159,18 @@ func (g *Game) start() error {
 }
 
 // passTurn passes the turn to the next player
-// the active player's clock pauses and the next player's clock unpauses.
+// the active player's clock pauses and the next player's clock unpauses once the game has started.
 func (g *Game) passTurn() {
-	g.playerTimers[g.ActivePlayer].Pause()
-	g.playerTimers[g.playerOrder[0]].Unpause()
+	if g.State == StateStarted {
+		g.playerTimers[g.ActivePlayer].Pause()
+		g.playerTimers[g.playerOrder[0]].Unpause()
+	}
+
+	g.rotatePlayers()
+}
 
+// rotatePlayers makes the next player in the order the active player.
+func (g *Game) rotatePlayers() {
 	g.ActivePlayer = g.playerOrder[0]
 	g.playerOrder = append(g.playerOrder[1:], g.playerOrder[0])
 }
@@ -185,7 +244,7 @@ func (g *Game) approveDraw(playerID uuid.UUID) error {
 		}
 
 		if allAccepted {
-			g.Drawn = g.playerOrder
+			g.Drawn = append([]uuid.UUID(nil), g.playerOrder...)
 			g.State = StateFinished
 			g.updateHandler.Publish(
 				models.UpdateMessage[GameUpdate]{
@@ -250,8 +309,9 @@ func (g *Game) rejectDraw() error {
 	return nil
 }
 
-// makeMove makes a move for the provided PlayerID if valid.
-func (g *Game) makeMove(playerID uuid.UUID, move board.Move) error {
+// makeMove makes a move for the provided PlayerID if valid,
+// the SAN is parsed in place of the move when provided.
+func (g *Game) makeMove(playerID uuid.UUID, move board.Move, san string) error {
 	g.mux.Lock()
 	defer g.mux.Unlock()
 
@@ -264,19 +324,38 @@ func (g *Game) makeMove(playerID uuid.UUID, move board.Move) error {
 		return errNotPlayersTurn(playerID.String())
 	}
 
-	moveErr := g.board.HandleMove(move)
-	if moveErr != nil {
-		return errInvalidMove(err)
+	if san != "" {
+		sanMove, sanErr := g.board.ParseSAN(san)
+		if sanErr != nil {
+			return errInvalidMove(sanErr)
+		}
+		move = sanMove
+	}
+
+	record, err := g.playMove(playerID, move)
+	if err != nil {
+		return err
 	}
 
 	g.passTurn()
+	g.applyEliminations()
+	g.applyGameEndState()
 
 	g.updateHandler.Publish(
 		models.UpdateMessage[GameUpdate]{
 			Channel: MessageChannel,
 			Type:    models.UpdateType_DELTA,
 			Data: GameUpdate{
-				ID: g.ID,
+				ID:           g.ID,
+				ActivePlayer: &g.ActivePlayer,
+				Moves:        &[]MoveRecord{record},
+				Winners:      &g.Winners,
+				Losers:       &g.Losers,
+				Drawn:        &g.Drawn,
+				EndReason:    &g.EndReason,
+				Checks:       g.getChecks(),
+				Reserves:     g.getReserves(),
+				State:        &g.State,
 			},
 		},
 	)
@@ -284,6 +363,175 @@ func (g *Game) makeMove(playerID uuid.UUID, move board.Move) error {
 	return nil
 }
 
+// claimDraw ends the game in a draw if the board allows the player to claim one.
+func (g *Game) claimDraw(playerID uuid.UUID) error {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+
+	err := g.isGameInState(StateStarted)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := g.ApprovedDraw[playerID]; !ok {
+		return errPlayerNotInGame
+	}
+
+	claimErr := g.board.ClaimDraw()
+	if claimErr != nil {
+		return errInvalidDrawClaim(claimErr)
+	}
+	g.applyGameEndState()
+
+	g.updateHandler.Publish(
+		models.UpdateMessage[GameUpdate]{
+			Channel: MessageChannel,
+			Type:    models.UpdateType_DELTA,
+			Data: GameUpdate{
+				ID:        g.ID,
+				Winners:   &g.Winners,
+				Losers:    &g.Losers,
+				Drawn:     &g.Drawn,
+				EndReason: &g.EndReason,
+				State:     &g.State,
+			},
+		},
+	)
+
+	return nil
+}
+
+// applyGameEndState finishes the game if its board has ended.
+func (g *Game) applyGameEndState() {
+	endState := g.board.GetGameEndState()
+	switch {
+	case endState.EndStateType.HasWinner():
+		winner, _ := g.getColorPlayer(endState.Winner)
+		g.Winners = []uuid.UUID{winner}
+		for _, color := range g.colors {
+			player, _ := g.getColorPlayer(color)
+			if player != winner && !containsPlayer(g.Losers, player) {
+				g.Losers = append(g.Losers, player)
+			}
+		}
+	case endState.EndStateType.IsDraw():
+		g.Drawn = append([]uuid.UUID(nil), g.playerOrder...)
+	default:
+		return
+	}
+
+	g.EndReason = endState.EndStateType
+	g.playerTimers[g.ActivePlayer].Pause()
+	g.State = StateFinished
+}
+
+// applyEliminations records the players the board has eliminated as losers in the order they were eliminated,
+// they leave the player order and the turn passes over them.
+func (g *Game) applyEliminations() {
+	for _, color := range g.board.GetEliminated() {
+		player, ok := g.getColorPlayer(color)
+		if !ok || containsPlayer(g.Losers, player) {
+			continue
+		}
+		g.Losers = append(g.Losers, player)
+		delete(g.ApprovedDraw, player)
+
+		playerOrder := []uuid.UUID{}
+		for _, p := range g.playerOrder {
+			if p != player {
+				playerOrder = append(playerOrder, p)
+			}
+		}
+		g.playerOrder = playerOrder
+
+		if g.ActivePlayer == player && len(g.playerOrder) > 0 {
+			g.passTurn()
+		}
+	}
+}
+
+// getColorPlayer returns the player of the Color's pieces,
+// the players play the board's colors in turn order starting with the color to move.
+func (g *Game) getColorPlayer(color board.Color) (uuid.UUID, bool) {
+	for i, c := range g.colors {
+		if c == color && i < len(g.players) {
+			return g.players[i], true
+		}
+	}
+	return uuid.UUID{}, false
+}
+
+// getChecks returns the number of checks each player has given, nil if the board doesn't count checks.
+func (g *Game) getChecks() *map[uuid.UUID]int {
+	checkCounts := g.board.GetCheckCounts()
+	if checkCounts == nil {
+		return nil
+	}
+
+	checks := map[uuid.UUID]int{}
+	for _, color := range g.colors {
+		if player, ok := g.getColorPlayer(color); ok {
+			checks[player] = checkCounts[color]
+		}
+	}
+	return &checks
+}
+
+// getBounds returns the bounds of the board.
+func (g *Game) getBounds() *board.Bounds {
+	bounds := g.board.GetBounds()
+	return &bounds
+}
+
+// getReserves returns the pieces in each player's reserve, nil if the board doesn't keep reserves.
+func (g *Game) getReserves() *map[uuid.UUID]map[board.PieceType]int {
+	boardReserves := g.board.GetReserves()
+	if boardReserves == nil {
+		return nil
+	}
+
+	reserves := map[uuid.UUID]map[board.PieceType]int{}
+	for _, color := range g.colors {
+		if player, ok := g.getColorPlayer(color); ok {
+			reserves[player] = boardReserves[color]
+		}
+	}
+	return &reserves
+}
+
+// playMove applies the move to the board and records it in the move history.
+func (g *Game) playMove(playerID uuid.UUID, move board.Move) (MoveRecord, error) {
+	// Notation and captures are read from the board before the move is applied.
+	san, sanErr := g.board.MoveToSAN(move)
+	if sanErr != nil {
+		return MoveRecord{}, errInvalidMove(sanErr)
+	}
+	capturedPiece := g.board.GetCapturedPiece(move)
+
+	moveErr := g.board.HandleMove(move)
+	if moveErr != nil {
+		return MoveRecord{}, errInvalidMove(moveErr)
+	}
+
+	record := MoveRecord{
+		Ply:        len(g.Moves) + 1,
+		PlayerID:   playerID,
+		Move:       move,
+		SAN:        san,
+		CheckState: g.board.GetCheckState(),
+		ClockMilis: g.Clocks[playerID],
+	}
+	if capturedPiece != nil {
+		record.CapturedPiece = &board.Piece{
+			Color:     capturedPiece.Color,
+			PieceType: capturedPiece.PieceType,
+		}
+	}
+	g.Moves = append(g.Moves, record)
+
+	return record, nil
+}
+
 // isGameInState checks if the Game is in the correct state.
 func (g *Game) isGameInState(required gameState) error {
 	if g.State != required {
@@ -324,6 +572,51 @@ func (g *Game) handleTimerUpdate(playerID uuid.UUID, t *timer.Timer) {
 	}
 }
 
+// GameFEN represents the FEN of a Game's board.
+type GameFEN struct {
+	ID  uuid.UUID `json:"id"`
+	FEN string    `json:"fen"`
+}
+
+// isFENSupported returns true if FEN and PGN can describe the game's board.
+func (g *Game) isFENSupported() bool {
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+
+	return g.board.IsFENSupported()
+}
+
+// getFEN returns the FEN of the game's board.
+func (g *Game) getFEN() GameFEN {
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+
+	return GameFEN{
+		ID:  g.ID,
+		FEN: g.board.FEN(),
+	}
+}
+
+// GameMoves represents the moves played in a Game.
+type GameMoves struct {
+	ID    uuid.UUID    `json:"id"`
+	Moves []MoveRecord `json:"moves"`
+}
+
+// getMoves returns the moves played in the game.
+func (g *Game) getMoves() GameMoves {
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+
+	moves := make([]MoveRecord, len(g.Moves))
+	copy(moves, g.Moves)
+
+	return GameMoves{
+		ID:    g.ID,
+		Moves: moves,
+	}
+}
+
 // getSnapshot returns a snapshot of the game state.
 func (g *Game) getSnapshot() GameUpdate {
 	g.mux.RLock()
@@ -337,7 +630,14 @@ func (g *Game) getSnapshot() GameUpdate {
 		Losers:       &g.Losers,
 		Drawn:        &g.Drawn,
 		ApprovedDraw: &g.ApprovedDraw,
+		EndReason:    &g.EndReason,
+		Checks:       g.getChecks(),
+		Reserves:     g.getReserves(),
 		State:        &g.State,
+		Moves:        &g.Moves,
 		BoardState:   g.board.GetState(),
+		Bounds:       g.getBounds(),
+
+		StartingPositionID: g.StartingPositionID,
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/variant64/server/pkg/errortypes"
+	"github.com/variant64/server/pkg/models/board"
 )
 
 var errGameNotFound = errortypes.New(errortypes.NotFound, "Game error: not found")
@@ -19,8 +20,6 @@ var errIncorrectGameState = func(required, current gameState) errortypes.TypedEr
 	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Game error: game is %s not %s", current, required))
 }
 
-var errUnableToCreateBoard = errortypes.New(errortypes.BadRequest, "Game error: unable to create game board")
-
 var errInvalidMove = func(error error) errortypes.TypedError {
 	return errortypes.New(errortypes.BadRequest, errors.Wrap(error, "Game error: invalid move ").Error())
 }
@@ -28,3 +27,19 @@ var errInvalidMove = func(error error) errortypes.TypedError {
 var errNotPlayersTurn = func(playerID string) errortypes.TypedError {
 	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Game error: incorrect player, not their turn %s", playerID))
 }
+
+var errInvalidPGNMove = func(ply int, error error) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, errors.Wrapf(error, "Game error: invalid PGN move %d ", ply).Error())
+}
+
+var errFENNotSupported = func(gameboardType board.GameboardType) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Game error: FEN and PGN don't support gameboard type %q", gameboardType))
+}
+
+var errPGNResultMismatch = func(result, boardResult string) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Game error: PGN result %s doesn't match the board result %s", result, boardResult))
+}
+
+var errInvalidDrawClaim = func(error error) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, errors.Wrap(error, "Game error: invalid draw claim ").Error())
+}
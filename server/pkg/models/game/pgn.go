@@ -0,0 +1,228 @@
+package game
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/variant64/server/pkg/models/board"
+	"github.com/variant64/server/pkg/models/player"
+)
+
+const (
+	pgnTagEvent   = "Event"
+	pgnTagSite    = "Site"
+	pgnTagDate    = "Date"
+	pgnTagRound   = "Round"
+	pgnTagWhite   = "White"
+	pgnTagBlack   = "Black"
+	pgnTagResult  = "Result"
+	pgnTagVariant = "Variant"
+	pgnTagSetUp   = "SetUp"
+	pgnTagFEN     = "FEN"
+
+	pgnUnknown = "?"
+)
+
+// pgnClockPattern matches a clock comment, e.g. [%clk 0:04:59].
+var pgnClockPattern = regexp.MustCompile(`\[%clk ([0-9]+):([0-9]{2}):([0-9]{2})\]`)
+
+// GamePGN represents a Game exported as PGN.
+type GamePGN struct {
+	ID  uuid.UUID `json:"id"`
+	PGN string    `json:"pgn"`
+}
+
+// getPGN returns the game exported as PGN.
+func (g *Game) getPGN() GamePGN {
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+
+	return GamePGN{
+		ID:  g.ID,
+		PGN: g.buildPGN().String(),
+	}
+}
+
+// buildPGN builds the PGN of the game with the Seven Tag Roster,
+// the variant, the starting position and each move's clock.
+func (g *Game) buildPGN() *board.PGN {
+	white, black := g.getColorPlayers()
+	result := g.getPGNResult(white, black)
+
+	date := "????.??.??"
+	if !g.startedAt.IsZero() {
+		date = g.startedAt.Format("2006.01.02")
+	}
+
+	variant := string(g.GameboardType)
+	if g.GameboardType == board.GameboardTypeDefault {
+		variant = string(board.GameboardTypeClassic)
+	}
+
+	pgn := &board.PGN{
+		Tags: []board.PGNTag{
+			{Name: pgnTagEvent, Value: pgnUnknown},
+			{Name: pgnTagSite, Value: pgnUnknown},
+			{Name: pgnTagDate, Value: date},
+			{Name: pgnTagRound, Value: pgnUnknown},
+			{Name: pgnTagWhite, Value: getDisplayName(white)},
+			{Name: pgnTagBlack, Value: getDisplayName(black)},
+			{Name: pgnTagResult, Value: result},
+			{Name: pgnTagVariant, Value: variant},
+		},
+		Moves:  make([]board.PGNMove, 0, len(g.Moves)),
+		Result: result,
+	}
+
+	// Games that don't start from the initial position record their FEN.
+	if g.startFEN != "" {
+		pgn.Tags = append(
+			pgn.Tags,
+			board.PGNTag{Name: pgnTagSetUp, Value: "1"},
+			board.PGNTag{Name: pgnTagFEN, Value: g.startFEN},
+		)
+	}
+
+	for _, record := range g.Moves {
+		pgn.Moves = append(pgn.Moves, board.PGNMove{
+			SAN:     record.SAN,
+			Comment: formatPGNClock(record.ClockMilis),
+		})
+	}
+
+	return pgn
+}
+
+// getColorPlayers returns the players of the white and black pieces,
+// the first player in the order plays the color to move in the starting position.
+func (g *Game) getColorPlayers() (uuid.UUID, uuid.UUID) {
+	white, _ := g.getColorPlayer(board.WHITE)
+	black, _ := g.getColorPlayer(board.BLACK)
+	return white, black
+}
+
+// getPGNResult returns the PGN result of the game.
+func (g *Game) getPGNResult(white, black uuid.UUID) string {
+	switch {
+	case len(g.Drawn) > 0:
+		return board.PGNResultDraw
+	case containsPlayer(g.Winners, white) || containsPlayer(g.Losers, black):
+		return board.PGNResultWhiteWins
+	case containsPlayer(g.Winners, black) || containsPlayer(g.Losers, white):
+		return board.PGNResultBlackWins
+	default:
+		return board.PGNResultOngoing
+	}
+}
+
+// replayPGN plays each move of the PGN in order, recording the clocks from the comments,
+// the game ends if the board has ended once the moves are replayed.
+func (g *Game) replayPGN(pgn *board.PGN) error {
+	for i, pgnMove := range pgn.Moves {
+		move, err := g.board.ParseSAN(pgnMove.SAN)
+		if err != nil {
+			return errInvalidPGNMove(i+1, err)
+		}
+
+		_, err = g.playMove(g.ActivePlayer, move)
+		if err != nil {
+			return errInvalidPGNMove(i+1, err)
+		}
+		if clockMilis, ok := parsePGNClock(pgnMove.Comment); ok {
+			g.Moves[len(g.Moves)-1].ClockMilis = clockMilis
+		}
+
+		g.rotatePlayers()
+		g.applyEliminations()
+	}
+	g.applyGameEndState()
+
+	return g.applyPGNResult(pgn.Result)
+}
+
+// applyPGNResult checks the PGN result against the replayed board,
+// a result decided off the board, e.g. by resignation, finishes the game as recorded.
+func (g *Game) applyPGNResult(result string) error {
+	white, black := g.getColorPlayers()
+	if g.State == StateFinished {
+		boardResult := g.getPGNResult(white, black)
+		if result != board.PGNResultOngoing && result != boardResult {
+			return errPGNResultMismatch(result, boardResult)
+		}
+		return nil
+	}
+
+	switch result {
+	case board.PGNResultWhiteWins:
+		g.Winners = []uuid.UUID{white}
+		g.Losers = []uuid.UUID{black}
+	case board.PGNResultBlackWins:
+		g.Winners = []uuid.UUID{black}
+		g.Losers = []uuid.UUID{white}
+	case board.PGNResultDraw:
+		g.Drawn = append([]uuid.UUID(nil), g.playerOrder...)
+	default:
+		return nil
+	}
+	g.State = StateFinished
+	return nil
+}
+
+// pgnGameboardType returns the GameboardType of a PGN Variant tag.
+func pgnGameboardType(variant string) board.GameboardType {
+	switch strings.ToLower(variant) {
+	case "", "standard":
+		return board.GameboardTypeDefault
+	case "fischerandom", "chess 960":
+		return board.GameboardTypeChess960
+	case "los alamos":
+		return board.GameboardTypeLosAlamos
+	case "king of the hill":
+		return board.GameboardTypeKingOfTheHill
+	case "three-check":
+		return board.GameboardTypeThreeCheck
+	default:
+		return board.GameboardType(strings.ToLower(variant))
+	}
+}
+
+// formatPGNClock returns the clock comment of a clock reading, e.g. [%clk 0:04:59].
+func formatPGNClock(clockMilis int64) string {
+	seconds := clockMilis / 1_000
+	return fmt.Sprintf("[%%clk %d:%02d:%02d]", seconds/3600, seconds/60%60, seconds%60)
+}
+
+// parsePGNClock returns the clock reading of a comment if it has one.
+func parsePGNClock(comment string) (int64, bool) {
+	groups := pgnClockPattern.FindStringSubmatch(comment)
+	if groups == nil {
+		return 0, false
+	}
+
+	hours, _ := strconv.ParseInt(groups[1], 10, 64)
+	minutes, _ := strconv.ParseInt(groups[2], 10, 64)
+	seconds, _ := strconv.ParseInt(groups[3], 10, 64)
+	return ((hours*60+minutes)*60 + seconds) * 1_000, true
+}
+
+// getDisplayName returns the display name of the player, unknown if they don't exist.
+func getDisplayName(playerID uuid.UUID) string {
+	p, err := (&player.RequestGetPlayer{PlayerID: playerID}).PerformAction()
+	if err != nil {
+		return pgnUnknown
+	}
+	return p.DisplayName
+}
+
+// containsPlayer returns true if the player is in the list.
+func containsPlayer(players []uuid.UUID, playerID uuid.UUID) bool {
+	for _, p := range players {
+		if p == playerID {
+			return true
+		}
+	}
+	return false
+}
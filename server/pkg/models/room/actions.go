@@ -5,6 +5,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/variant64/server/pkg/models"
+	"github.com/variant64/server/pkg/models/board"
+	"github.com/variant64/server/pkg/models/board/variants"
 	"github.com/variant64/server/pkg/models/game"
 	"github.com/variant64/server/pkg/models/player"
 )
@@ -14,6 +16,8 @@ var roomUpdateBus = models.NewUpdateBus[RoomUpdate]()
 // RequestNewRoom is used to create a new Room.
 type RequestNewRoom struct {
 	Name string `json:"room_name" mapstructure:"room_name"`
+	// GameboardType is the variant the Room is for, its player count limits the players who can join.
+	GameboardType board.GameboardType `json:"gameboard_type" mapstructure:"gameboard_type"`
 }
 
 // PerformAction creates a new Room.
@@ -26,12 +30,22 @@ func (r *RequestNewRoom) PerformAction() (*Room, error) {
 		return nil, errNameTooLong
 	}
 
+	playerLimit := PLAYER_LIMIT_DEFAULT
+	if r.GameboardType != board.GameboardTypeDefault {
+		variant, err := variants.GetVariant(r.GameboardType)
+		if err != nil {
+			return nil, err
+		}
+		playerLimit = variant.PlayerCount
+	}
+
 	room := &Room{
-		ID:          uuid.New(),
-		Name:        r.Name,
-		Players:     make(map[uuid.UUID]string, 0),
-		PlayerLimit: PLAYER_LIMIT_DEFAULT,
-		mux:         &sync.RWMutex{},
+		ID:            uuid.New(),
+		Name:          r.Name,
+		Players:       make(map[uuid.UUID]string, 0),
+		PlayerLimit:   playerLimit,
+		GameboardType: r.GameboardType,
+		mux:           &sync.RWMutex{},
 	}
 
 	handler, err := models.NewUpdatePub(room.ID, roomUpdateBus)
@@ -101,7 +115,7 @@ func (r *RequestJoinRoom) PerformAction() (*Room, error) {
 	defer room.mux.Unlock()
 
 	if len(room.Players) == room.PlayerLimit {
-		return nil, errPlayerLimit
+		return nil, errPlayerLimit(room.PlayerLimit)
 	}
 
 	if _, ok := room.Players[r.PlayerID]; ok {
@@ -170,9 +184,12 @@ func (r *RequestLeaveRoom) PerformAction() (*Room, error) {
 type RequestStartGame struct {
 	RoomID          uuid.UUID `json:"room_id" mapstructure:"room_id"`
 	PlayerTimeMilis int64     `json:"player_time_ms"`
+	FEN             string    `json:"fen"`
+	PGN             string    `json:"pgn"`
+	Seed            int64     `json:"seed"`
 }
 
-// PerformAction starts a game.Game in a Room.
+// PerformAction starts a game.Game of the Room's variant in a Room.
 func (r *RequestStartGame) PerformAction() (*game.Game, error) {
 	room, err := (&RequestGetRoom{RoomID: r.RoomID}).PerformAction()
 	if err != nil || room == nil {
@@ -190,6 +207,10 @@ func (r *RequestStartGame) PerformAction() (*game.Game, error) {
 	gameEntity, err := (&game.RequestNewGame{
 		PlayerOrder:     players,
 		PlayerTimeMilis: r.PlayerTimeMilis,
+		GameboardType:   room.GameboardType,
+		FEN:             r.FEN,
+		PGN:             r.PGN,
+		Seed:            r.Seed,
 	}).PerformAction()
 	if err != nil || gameEntity == nil {
 		return nil, err
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/variant64/server/pkg/models/board"
 	"github.com/variant64/server/pkg/models/player"
 )
 
@@ -79,7 +80,7 @@ func TestRequestJoinRoom(t *testing.T) {
 				{RoomID: room3.GetID(), PlayerID: playerID2},
 				{RoomID: room3.GetID(), PlayerID: playerID3},
 			},
-			expectedRequestErrors: []error{nil, nil, errPlayerLimit},
+			expectedRequestErrors: []error{nil, nil, errPlayerLimit(PLAYER_LIMIT_DEFAULT)},
 			expectedPlayers: map[uuid.UUID]string{
 				playerID1: "name1",
 				playerID2: "name2",
@@ -264,3 +265,81 @@ func TestRequestRoomStartGame(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestNewRoomPlayerLimit(t *testing.T) {
+	testcases := []struct {
+		name                string
+		gameboardType       board.GameboardType
+		expectedPlayerLimit int
+		expectErr           bool
+	}{
+		{
+			name:                "Default player limit.",
+			expectedPlayerLimit: PLAYER_LIMIT_DEFAULT,
+		},
+		{
+			name:                "Four-player variant.",
+			gameboardType:       board.GameboardTypeFourPlayer,
+			expectedPlayerLimit: 4,
+		},
+		{
+			name:          "Unknown variant.",
+			gameboardType: "unknown",
+			expectErr:     true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			room, err := (&RequestNewRoom{Name: "room", GameboardType: tc.gameboardType}).PerformAction()
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedPlayerLimit, room.PlayerLimit)
+		})
+	}
+}
+
+func TestRequestStartGameVariant(t *testing.T) {
+	testcases := []struct {
+		name          string
+		gameboardType board.GameboardType
+		expectErr     bool
+	}{
+		{
+			name:          "Room variant.",
+			gameboardType: board.GameboardTypeCapablanca,
+		},
+		{
+			name:          "Not enough players for the room variant.",
+			gameboardType: board.GameboardTypeFourPlayer,
+			expectErr:     true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			room, err := (&RequestNewRoom{Name: "room", GameboardType: tc.gameboardType}).PerformAction()
+			assert.Nil(t, err)
+			assert.Equal(t, tc.gameboardType, room.GameboardType)
+
+			for _, displayName := range []string{"name1", "name2"} {
+				p, err := (&player.RequestNewPlayer{DisplayName: displayName}).PerformAction()
+				assert.Nil(t, err)
+				_, err = (&RequestJoinRoom{RoomID: room.GetID(), PlayerID: p.ID}).PerformAction()
+				assert.Nil(t, err)
+			}
+
+			game, err := (&RequestStartGame{RoomID: room.GetID(), PlayerTimeMilis: 1_000}).PerformAction()
+			if tc.expectErr {
+				assert.Nil(t, game)
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.gameboardType, game.GameboardType)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/variant64/server/pkg/models"
+	"github.com/variant64/server/pkg/models/board"
 )
 
 const (
@@ -19,6 +20,8 @@ type Room struct {
 
 	Players     map[uuid.UUID]string `json:"players"`
 	PlayerLimit int                  `json:"player_limit"`
+	// GameboardType is the variant of the Games started in the Room.
+	GameboardType board.GameboardType `json:"gameboard_type"`
 
 	GameID *uuid.UUID `json:"game_id"`
 
@@ -8,7 +8,9 @@ import (
 
 var errRoomNotFound = errortypes.New(errortypes.NotFound, "Room error: not found")
 
-var errPlayerLimit = errortypes.New(errortypes.BadRequest, fmt.Sprintf("Room error: room has reached player_limit %d.", PLAYER_LIMIT_DEFAULT))
+var errPlayerLimit = func(playerLimit int) errortypes.TypedError {
+	return errortypes.New(errortypes.BadRequest, fmt.Sprintf("Room error: room has reached player_limit %d.", playerLimit))
+}
 
 var errMissingName = errortypes.New(errortypes.BadRequest, "Room error: room_name is required")
 